@@ -0,0 +1,208 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// Error given by Call() while the circuit is open.
+var ErrCircuitOpen = errors.New("breaker: circuit open")
+
+type State int
+
+const (
+	// Calls go through. Failures are counted.
+	Closed State = iota
+	// Calls are rejected with ErrCircuitOpen until Settings.OpenTimeout passes.
+	Open
+	// One trial call at a time goes through. Enough successes close the
+	// circuit, a failure opens it again.
+	HalfOpen
+)
+
+func (self State) String() string {
+	switch self {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	}
+	return "half-open"
+}
+
+type Settings struct {
+	// Consecutive failures which open the circuit. 0 means 1.
+	FailureThreshold int
+	// Time the circuit stays open before a trial call is let through.
+	OpenTimeout time.Duration
+	// Consecutive successful trial calls which close the circuit. 0 means 1.
+	HalfOpenSuccesses int
+	// Reports whether an error counts as a failure. Other errors count as
+	// successes. nil means every error is a failure.
+	IsFailure func(error) bool
+	// Called after every state change, once the Breaker's lock is released,
+	// so it may call State() or Counts(). Calls from different goroutines
+	// may overlap.
+	OnStateChange func(from, to State)
+	// Time source of OpenTimeout. nil means time.Now.
+	Now func() time.Time
+}
+
+// Counters of a Breaker since its creation, apart from the consecutive ones
+// which are reset on every state change. Calls finishing after a state change
+// which happened while they ran count as Requests only: their outcome is
+// ignored.
+type Counts struct {
+	Requests int
+	Successes int
+	Failures int
+	Rejections int
+	ConsecutiveSuccesses int
+	ConsecutiveFailures int
+}
+
+// Circuit breaker for functions returning a Result. See Call().
+type Breaker struct {
+	mutex sync.Mutex
+	settings Settings
+	state State
+	openedAt time.Time
+	// Bumped on every state change. Outcomes of calls started in an older
+	// generation are ignored.
+	generation uint64
+	trialRunning bool
+	counts Counts
+	// State changes to report once the lock is released.
+	changes []stateChange
+}
+
+type stateChange struct {
+	from State
+	to State
+}
+
+func New(settings Settings) *Breaker {
+	if settings.FailureThreshold < 1 {
+		settings.FailureThreshold = 1
+	}
+	if settings.HalfOpenSuccesses < 1 {
+		settings.HalfOpenSuccesses = 1
+	}
+	if settings.Now == nil {
+		settings.Now = time.Now
+	}
+	return &Breaker{settings: settings}
+}
+
+func (self *Breaker) State() State {
+	self.mutex.Lock()
+	defer self.unlock()
+	self.checkTimeout()
+	return self.state
+}
+
+func (self *Breaker) Counts() Counts {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.counts
+}
+
+// Runs the given function through the breaker.
+// Circuit open -> Err{ErrCircuitOpen} without calling the function
+// Otherwise -> return value of the function, which is counted as a success or a failure
+// > a panic in the function counts as a failure and is re-raised.
+func Call[T any](breaker *Breaker, ctx context.Context, callFunc func(context.Context) Result[T]) Result[T] {
+	generation, ok := breaker.before()
+	if !ok {
+		return &Err[T]{ErrCircuitOpen}
+	}
+	done := false
+	defer func() {
+		if !done {
+			breaker.after(generation, false)
+		}
+	}()
+	res := callFunc(ctx)
+	done = true
+	breaker.after(generation, res.IsOk() || (breaker.settings.IsFailure != nil && !breaker.settings.IsFailure(res.UnwrapErr())))
+	return res
+}
+
+// Reports whether a call may go through and the generation it belongs to.
+func (self *Breaker) before() (uint64, bool) {
+	self.mutex.Lock()
+	defer self.unlock()
+	self.checkTimeout()
+	if self.state == Open || (self.state == HalfOpen && self.trialRunning) {
+		self.counts.Rejections++
+		return self.generation, false
+	}
+	self.counts.Requests++
+	self.trialRunning = self.state == HalfOpen
+	return self.generation, true
+}
+
+func (self *Breaker) after(generation uint64, success bool) {
+	self.mutex.Lock()
+	defer self.unlock()
+	self.checkTimeout()
+	if generation != self.generation {
+		return
+	}
+	// Only the trial call runs in a HalfOpen generation.
+	self.trialRunning = false
+	if success {
+		self.counts.Successes++
+		self.counts.ConsecutiveSuccesses++
+		self.counts.ConsecutiveFailures = 0
+		if self.state == HalfOpen && self.counts.ConsecutiveSuccesses >= self.settings.HalfOpenSuccesses {
+			self.setState(Closed)
+		}
+		return
+	}
+	self.counts.Failures++
+	self.counts.ConsecutiveFailures++
+	self.counts.ConsecutiveSuccesses = 0
+	if self.state == HalfOpen || self.counts.ConsecutiveFailures >= self.settings.FailureThreshold {
+		self.setState(Open)
+	}
+}
+
+// Releases the lock, then calls OnStateChange for the changes made while it
+// was held.
+func (self *Breaker) unlock() {
+	changes := self.changes
+	self.changes = nil
+	self.mutex.Unlock()
+	for _, change := range changes {
+		self.settings.OnStateChange(change.from, change.to)
+	}
+}
+
+// Moves from Open to HalfOpen once the timeout has passed.
+func (self *Breaker) checkTimeout() {
+	if self.state == Open && !self.settings.Now().Before(self.openedAt.Add(self.settings.OpenTimeout)) {
+		self.setState(HalfOpen)
+	}
+}
+
+func (self *Breaker) setState(state State) {
+	if state == self.state {
+		return
+	}
+	from := self.state
+	self.state = state
+	self.generation++
+	self.trialRunning = false
+	self.counts.ConsecutiveSuccesses = 0
+	self.counts.ConsecutiveFailures = 0
+	if state == Open {
+		self.openedAt = self.settings.Now()
+	}
+	if self.settings.OnStateChange != nil {
+		self.changes = append(self.changes, stateChange{from, state})
+	}
+}
@@ -0,0 +1,143 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+	"github.com/Sh1kharGupta/easyerror/internal/clock"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestBreaker(t *testing.T) {
+	myError := errors.New("MyError")
+	ignored := errors.New("Ignored")
+	ctx := context.Background()
+	fake := &clock.Fake{}
+	var changes []State
+	breaker := New(Settings{
+		FailureThreshold: 2,
+		OpenTimeout: time.Minute,
+		HalfOpenSuccesses: 2,
+		IsFailure: func(err error) bool {return err != ignored},
+		OnStateChange: func(from, to State) {changes = append(changes, to)},
+		Now: fake.Now,
+	})
+	calls := 0
+	returning := func(res Result[int]) func(context.Context) Result[int] {
+		return func(context.Context) Result[int] {calls++; return res}
+	}
+	fail := returning(&Err[int]{myError})
+
+	Assert(Call[int](breaker, ctx, returning(&Ok[int]{1})).Unwrap() == 1)
+	Assert(Call[int](breaker, ctx, fail).UnwrapErr() == myError)
+	Assert(Call[int](breaker, ctx, returning(&Err[int]{ignored})).UnwrapErr() == ignored)
+	Assert(breaker.State() == Closed)
+	Call[int](breaker, ctx, fail)
+	Assert(breaker.State() == Closed)
+	Call[int](breaker, ctx, fail)
+	Assert(breaker.State() == Open)
+
+	calls = 0
+	res := Call[int](breaker, ctx, returning(&Ok[int]{1}))
+	Assert(res.UnwrapErr() == ErrCircuitOpen && calls == 0)
+	Assert(res.OrElse(func(error) Result[int] {return &Ok[int]{2}}).Unwrap() == 2)
+
+	fake.Advance(time.Minute)
+	Assert(breaker.State() == HalfOpen)
+	Call[int](breaker, ctx, fail)
+	Assert(breaker.State() == Open)
+
+	fake.Advance(time.Minute)
+	Call[int](breaker, ctx, returning(&Ok[int]{1}))
+	Assert(breaker.State() == HalfOpen)
+	Call[int](breaker, ctx, returning(&Ok[int]{1}))
+	Assert(breaker.State() == Closed)
+	Assert(reflect.DeepEqual(changes, []State{Open, HalfOpen, Open, HalfOpen, Closed}))
+	Assert(breaker.Counts() == Counts{
+		Requests: 8, Successes: 4, Failures: 4, Rejections: 1,
+	})
+	Assert(HalfOpen.String() == "half-open")
+}
+
+func TestBreakerOnStateChange(t *testing.T) {
+	ctx := context.Background()
+	fake := &clock.Fake{}
+	var breaker *Breaker
+	var states []State
+	breaker = New(Settings{
+		OpenTimeout: time.Second,
+		// Reads the Breaker, which needs its lock.
+		OnStateChange: func(from, to State) {states = append(states, breaker.State())},
+		Now: fake.Now,
+	})
+	Call[int](breaker, ctx, func(context.Context) Result[int] {return &Err[int]{errors.New("MyError")}})
+	fake.Advance(time.Second)
+	Assert(breaker.State() == HalfOpen)
+	Call[int](breaker, ctx, func(context.Context) Result[int] {return &Ok[int]{1}})
+	Assert(reflect.DeepEqual(states, []State{Open, HalfOpen, Closed}))
+}
+
+func TestBreakerTrial(t *testing.T) {
+	ctx := context.Background()
+	fake := &clock.Fake{}
+	breaker := New(Settings{OpenTimeout: time.Second, Now: fake.Now})
+
+	// A panic counts as a failure.
+	Assert(Recover[string](func() {
+		Call[int](breaker, ctx, func(context.Context) Result[int] {panic("boom")})
+	}) == "boom")
+	Assert(breaker.State() == Open)
+
+	// Only one trial call runs at a time.
+	fake.Advance(time.Second)
+	res := Call[int](breaker, ctx, func(ctx context.Context) Result[int] {
+		return Call[int](breaker, ctx, func(context.Context) Result[int] {return &Ok[int]{1}})
+	})
+	Assert(res.UnwrapErr() == ErrCircuitOpen)
+	Assert(breaker.State() == Open) // The trial returned an Err.
+	Assert(breaker.Counts().Rejections == 1)
+}
+
+func TestBreakerGenerations(t *testing.T) {
+	myError := errors.New("MyError")
+	ctx := context.Background()
+	fake := &clock.Fake{}
+	breaker := New(Settings{FailureThreshold: 1, OpenTimeout: time.Second, Now: fake.Now})
+	blocking := func(started chan<- struct{}, release <-chan struct{}) func(context.Context) Result[int] {
+		return func(context.Context) Result[int] {
+			started <- struct{}{}
+			<-release
+			return &Ok[int]{1}
+		}
+	}
+	run := func(callFunc func(context.Context) Result[int]) <-chan Result[int] {
+		done := make(chan Result[int])
+		go func() {done <- Call[int](breaker, ctx, callFunc)}()
+		return done
+	}
+
+	// A slow call starts while Closed...
+	slowStarted, slowRelease := make(chan struct{}), make(chan struct{})
+	slowDone := run(blocking(slowStarted, slowRelease))
+	<-slowStarted
+	Call[int](breaker, ctx, func(context.Context) Result[int] {return &Err[int]{myError}})
+	Assert(breaker.State() == Open)
+
+	// ...and finishes while the HalfOpen trial runs.
+	fake.Advance(time.Second)
+	trialStarted, trialRelease := make(chan struct{}), make(chan struct{})
+	trialDone := run(blocking(trialStarted, trialRelease))
+	<-trialStarted
+	close(slowRelease)
+	Assert((<-slowDone).Unwrap() == 1)
+	Assert(breaker.State() == HalfOpen) // The slow success is ignored.
+	Assert(Call[int](breaker, ctx, func(context.Context) Result[int] {return &Ok[int]{2}}).UnwrapErr() == ErrCircuitOpen)
+
+	close(trialRelease)
+	Assert((<-trialDone).Unwrap() == 1)
+	Assert(breaker.State() == Closed)
+	Assert(breaker.Counts() == Counts{Requests: 3, Successes: 1, Failures: 1, Rejections: 1})
+}
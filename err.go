@@ -1,6 +1,10 @@
 package easyerror
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+)
 
 // Implements the Result interface. Holds an error value.
 // See the interface for documentation of methods.
@@ -16,12 +20,20 @@ func (self *Err[T]) IsErr() bool {
 	return true
 }
 
+func (self *Err[T]) IsOkAnd(predicate func(T) bool) bool {
+	return false
+}
+
+func (self *Err[T]) IsErrAnd(predicate func(error) bool) bool {
+	return predicate(self.Error)
+}
+
 func (self *Err[T]) Expect(msg string) T {
-	panic(&Err[T]{fmt.Errorf("%s: %w", msg, self.Error)})
+	panic(&sentinel.Panic{Err: withStack(fmt.Errorf("%s: %w", msg, self.Error), 1), PC: sentinel.Caller()})
 }
 
 func (self *Err[T]) Unwrap() T {
-	panic(self)
+	panic(&sentinel.Panic{Err: self.Error, PC: sentinel.Caller()})
 }
 
 func (self *Err[T]) UnwrapOr(defaultValue T) T {
@@ -32,10 +44,19 @@ func (self *Err[T]) UnwrapOrElse(defaultFunc func() T) T {
 	return defaultFunc()
 }
 
+func (self *Err[T]) UnwrapOrDefault() T {
+	var zero T
+	return zero
+}
+
 func (self *Err[T]) UnwrapErr() error {
 	return self.Error
 }
 
+func (self *Err[T]) ExpectErr(msg string) error {
+	return self.Error
+}
+
 func (self *Err[T]) Err() Option[error] {
 	return &Some[error]{self.Error}
 }
@@ -75,3 +96,16 @@ func (self *Err[T]) AndThen(transformFunc func(T) Result[T]) Result[T] {
 func (self *Err[T]) OrElse(transformFunc func(error) Result[T]) Result[T] {
 	return transformFunc(self.Error)
 }
+
+func (self *Err[T]) Inspect(inspectFunc func(T)) Result[T] {
+	return self
+}
+
+func (self *Err[T]) InspectErr(inspectFunc func(error)) Result[T] {
+	inspectFunc(self.Error)
+	return self
+}
+
+func (self *Err[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {}
+}
@@ -0,0 +1,110 @@
+package easyerror
+
+import (
+	"iter"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+)
+
+// Implements the ResultE interface. Holds an error value of type E.
+// See the interface for documentation of methods.
+type ErrE[T, E any] struct {
+	Error E
+}
+
+func (self *ErrE[T, E]) IsOk() bool {
+	return false
+}
+
+func (self *ErrE[T, E]) IsErr() bool {
+	return true
+}
+
+func (self *ErrE[T, E]) IsOkAnd(predicate func(T) bool) bool {
+	return false
+}
+
+func (self *ErrE[T, E]) IsErrAnd(predicate func(E) bool) bool {
+	return predicate(self.Error)
+}
+
+func (self *ErrE[T, E]) Expect(msg string) T {
+	panic(&sentinel.Panic{Err: self.Error, Msg: msg, PC: sentinel.Caller()})
+}
+
+func (self *ErrE[T, E]) Unwrap() T {
+	panic(&sentinel.Panic{Err: self.Error, PC: sentinel.Caller()})
+}
+
+func (self *ErrE[T, E]) UnwrapOr(defaultValue T) T {
+	return defaultValue
+}
+
+func (self *ErrE[T, E]) UnwrapOrElse(defaultFunc func() T) T {
+	return defaultFunc()
+}
+
+func (self *ErrE[T, E]) UnwrapOrDefault() T {
+	var zero T
+	return zero
+}
+
+func (self *ErrE[T, E]) UnwrapErr() E {
+	return self.Error
+}
+
+func (self *ErrE[T, E]) ExpectErr(msg string) E {
+	return self.Error
+}
+
+func (self *ErrE[T, E]) Err() Option[E] {
+	return &Some[E]{self.Error}
+}
+
+func (self *ErrE[T, E]) Ok() Option[T] {
+	return &None[T]{}
+}
+
+func (self *ErrE[T, E]) Map(transformFunc func(T) T) ResultE[T, E] {
+	return self
+}
+
+func (self *ErrE[T, E]) MapErr(transformFunc func(E) E) ResultE[T, E] {
+	return &ErrE[T, E]{transformFunc(self.Error)}
+}
+
+func (self *ErrE[T, E]) MapOr(defaultValue T, transformFunc func(T) T) T {
+	return defaultValue
+}
+
+func (self *ErrE[T, E]) MapOrElse(defaultFunc func() T, transformFunc func(T) T) T {
+	return defaultFunc()
+}
+
+func (self *ErrE[T, E]) And(second ResultE[T, E]) ResultE[T, E] {
+	return self
+}
+
+func (self *ErrE[T, E]) Or(second ResultE[T, E]) ResultE[T, E] {
+	return second
+}
+
+func (self *ErrE[T, E]) AndThen(transformFunc func(T) ResultE[T, E]) ResultE[T, E] {
+	return self
+}
+
+func (self *ErrE[T, E]) OrElse(transformFunc func(E) ResultE[T, E]) ResultE[T, E] {
+	return transformFunc(self.Error)
+}
+
+func (self *ErrE[T, E]) Inspect(inspectFunc func(T)) ResultE[T, E] {
+	return self
+}
+
+func (self *ErrE[T, E]) InspectErr(inspectFunc func(E)) ResultE[T, E] {
+	inspectFunc(self.Error)
+	return self
+}
+
+func (self *ErrE[T, E]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {}
+}
@@ -1,5 +1,7 @@
 package easyerror
 
+import "iter"
+
 type Option[T any] interface {
 	// Some{Value} -> true
 	// None{} -> false
@@ -9,8 +11,22 @@ type Option[T any] interface {
 	// None{} -> true
 	IsNone() bool
 
+	// Some{Value} -> func(Value)
+	// None{} -> false
+	IsSomeAnd(func(T) bool) bool
+
+	// Some{Value} -> func(Value)
+	// None{} -> true
+	IsNoneOr(func(T) bool) bool
+
 	// Some{Value} -> Value
-	// None{} -> panic(self) - can be caught using option.Catch()
+	// None{} -> panic(fmt.Errorf(given string, easyerror.ErrNone))
+	// > can be caught with option.Catch() or result.Catch()
+	Expect(string) T
+
+	// Some{Value} -> Value
+	// None{} -> panic - can be caught using option.Catch()
+	// > or by result.Catch() which gives Err{easyerror.ErrNone}
 	Unwrap() T
 
 	// Some{Value} -> Value
@@ -21,6 +37,10 @@ type Option[T any] interface {
 	// None{} -> return value of given function
 	UnwrapOrElse(func() T) T
 
+	// Some{Value} -> Value
+	// None{} -> zero value of T
+	UnwrapOrDefault() T
+
 	// Some{Value} -> Ok{Value}
 	// None{} -> Err{given error}
 	OkOr(error) Result[T]
@@ -69,6 +89,14 @@ type Option[T any] interface {
 	// Some{Value} -> Some{Value}
 	// None{} -> return value of given function
 	OrElse(func() Option[T]) Option[T]
+
+	// Some{Value} -> calls func(Value) and returns Some{Value}
+	// None{} -> None{}
+	Inspect(func(T)) Option[T]
+
+	// Some{Value} -> sequence yielding Value once
+	// None{} -> empty sequence
+	Iter() iter.Seq[T]
 }
 
 type Result[T any] interface {
@@ -80,14 +108,23 @@ type Result[T any] interface {
 	// Err{Error} -> true
 	IsErr() bool
 
+	// Ok{Value} -> func(Value)
+	// Err{Error} -> false
+	IsOkAnd(func(T) bool) bool
+
+	// Ok{Value} -> false
+	// Err{Error} -> func(Error)
+	IsErrAnd(func(error) bool) bool
+
 	// Ok{Value} -> Value
-	// Err{Error} -> panic(Err{fmt.Errorf(given string, Error)})
+	// Err{Error} -> panic(fmt.Errorf(given string, Error))
 	// > wraps the underlying Error with given string.
 	// > can be caught with result.Catch()
 	Expect(string) T
 
 	// Ok{Value} -> Value
-	// Err{Error} -> panic(self) - can be caught with result.Catch()
+	// Err{Error} -> panic(Error) - can be caught with result.Catch()
+	// > or by option.Catch() which gives None{}
 	Unwrap() T
 
 	// Ok{Value} -> Value
@@ -98,10 +135,19 @@ type Result[T any] interface {
 	// Err{Error} -> return value of given function
 	UnwrapOrElse(func() T) T
 
+	// Ok{Value} -> Value
+	// Err{Error} -> zero value of T
+	UnwrapOrDefault() T
+
 	// Ok{Value} -> panic(generic string)
 	// Err{Error} -> Error
 	UnwrapErr() error
 
+	// Ok{Value} -> panic(fmt.Errorf(given string, easyerror.ErrOk, Value))
+	// > can be caught with result.Catch()
+	// Err{Error} -> Error
+	ExpectErr(string) error
+
 	// Ok{Value} -> None{}
 	// Err{Error} -> Some{Error}
 	Err() Option[error]
@@ -141,4 +187,121 @@ type Result[T any] interface {
 	// Ok{Value} -> Ok{Value}
 	// Err{Error} -> func(Error)
 	OrElse(func(error) Result[T]) Result[T]
+
+	// Ok{Value} -> calls func(Value) and returns Ok{Value}
+	// Err{Error} -> Err{Error}
+	Inspect(func(T)) Result[T]
+
+	// Ok{Value} -> Ok{Value}
+	// Err{Error} -> calls func(Error) and returns Err{Error}
+	InspectErr(func(error)) Result[T]
+
+	// Ok{Value} -> sequence yielding Value once
+	// Err{Error} -> empty sequence
+	Iter() iter.Seq[T]
+}
+
+// Same as Result except that the error value is of a user-chosen type E
+// instead of the error interface. Implemented by OkE and ErrE.
+type ResultE[T, E any] interface {
+	// Ok{Value} -> true
+	// Err{Error} -> false
+	IsOk() bool
+
+	// Ok{Value} -> false
+	// Err{Error} -> true
+	IsErr() bool
+
+	// Ok{Value} -> func(Value)
+	// Err{Error} -> false
+	IsOkAnd(func(T) bool) bool
+
+	// Ok{Value} -> false
+	// Err{Error} -> func(Error)
+	IsErrAnd(func(E) bool) bool
+
+	// Ok{Value} -> Value
+	// Err{Error} -> panic(given string, Error)
+	// > can be caught with resulte.Catch() which restores Err{Error}
+	// > when E is error, the caught Error is wrapped with the given string like Err.Expect() does
+	// > for any other E, the given string is only kept when the panic is not caught
+	Expect(string) T
+
+	// Ok{Value} -> Value
+	// Err{Error} -> panic(Error) - can be caught with resulte.Catch()
+	Unwrap() T
+
+	// Ok{Value} -> Value
+	// Err{Error} -> given arg value
+	UnwrapOr(T) T
+
+	// Ok{Value} -> Value
+	// Err{Error} -> return value of given function
+	UnwrapOrElse(func() T) T
+
+	// Ok{Value} -> Value
+	// Err{Error} -> zero value of T
+	UnwrapOrDefault() T
+
+	// Ok{Value} -> panic(generic string)
+	// Err{Error} -> Error
+	UnwrapErr() E
+
+	// Ok{Value} -> panic(fmt.Errorf(given string, easyerror.ErrOk, Value))
+	// > can be caught with result.Catch(), or with resulte.Catch() when E is error
+	// Err{Error} -> Error
+	ExpectErr(string) E
+
+	// Ok{Value} -> None{}
+	// Err{Error} -> Some{Error}
+	Err() Option[E]
+
+	// Ok{Value} -> Some{Value}
+	// Err{Error} -> None{}
+	Ok() Option[T]
+
+	// Ok{Value} -> Ok{func(Value)}
+	// Err{Error} -> Err{Error}
+	Map(func(T) T) ResultE[T, E]
+
+	// Ok{Value} -> Ok{Value}
+	// Err{Error} -> Err{func(Error)}
+	// > see resulte.MapErr() to change the error type
+	MapErr(func(E) E) ResultE[T, E]
+
+	// Ok{Value} -> func(Value)
+	// Err{Error} -> given arg value
+	MapOr(T, func(T) T) T
+
+	// Ok{Value} -> second_func(Value)
+	// Err{Error} -> return value of first function
+	MapOrElse(func() T, func(T) T) T
+
+	// Ok{Value} & Any -> Any
+	// Err{Error} & Any -> Err{Error}
+	And(ResultE[T, E]) ResultE[T, E]
+
+	// Ok{Value} | Any -> Ok{Value}
+	// Err{Error} | Any -> Any
+	Or(ResultE[T, E]) ResultE[T, E]
+
+	// Ok{Value} -> func(Value)
+	// Err{Error} -> Err{Error}
+	AndThen(func(T) ResultE[T, E]) ResultE[T, E]
+
+	// Ok{Value} -> Ok{Value}
+	// Err{Error} -> func(Error)
+	OrElse(func(E) ResultE[T, E]) ResultE[T, E]
+
+	// Ok{Value} -> calls func(Value) and returns Ok{Value}
+	// Err{Error} -> Err{Error}
+	Inspect(func(T)) ResultE[T, E]
+
+	// Ok{Value} -> Ok{Value}
+	// Err{Error} -> calls func(Error) and returns Err{Error}
+	InspectErr(func(E)) ResultE[T, E]
+
+	// Ok{Value} -> sequence yielding Value once
+	// Err{Error} -> empty sequence
+	Iter() iter.Seq[T]
 }
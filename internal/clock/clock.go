@@ -0,0 +1,44 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Time source for tests. Time only moves through Advance() and After(),
+// whose channel is ready at once after moving the time forward. Pass its
+// methods where a Now or After func is taken. Safe for concurrent use.
+type Fake struct {
+	mutex sync.Mutex
+	now time.Time
+	delays []time.Duration
+}
+
+func (self *Fake) Now() time.Time {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.now
+}
+
+func (self *Fake) After(d time.Duration) <-chan time.Time {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	self.delays = append(self.delays, d)
+	self.now = self.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- self.now
+	return ch
+}
+
+func (self *Fake) Advance(d time.Duration) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	self.now = self.now.Add(d)
+}
+
+// Returns the durations given to After() so far.
+func (self *Fake) Delays() []time.Duration {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return append([]time.Duration(nil), self.delays...)
+}
@@ -0,0 +1,36 @@
+package sentinel
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Panic value of Unwrap() and Expect() on None{}, Err{} and ErrE{}. Being
+// internal, it can't be created outside easyerror, so the Catch() functions
+// can trust any panic of this type without reflection.
+type Panic struct {
+	// Set when the panic comes from None{}.
+	None bool
+	// Error held by Err{} or ErrE{}. easyerror.ErrNone for None{}.
+	Err any
+	// Message given to Expect(), if it was not already wrapped into Err.
+	Msg string
+	// Location of the Unwrap() or Expect() call, as returned by Caller().
+	PC uintptr
+}
+
+// Returns the program counter of the caller of the function calling Caller().
+// Records a single frame, so it is cheap next to the cost of the panic itself.
+func Caller() uintptr {
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:])
+	return pcs[0]
+}
+
+// Printed by the runtime when the panic is not caught.
+func (self *Panic) Error() string {
+	if self.Msg != "" {
+		return fmt.Sprintf("%s: %v", self.Msg, self.Err)
+	}
+	return fmt.Sprint(self.Err)
+}
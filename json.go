@@ -0,0 +1,167 @@
+package easyerror
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// Sentinel errors registered with RegisterError(), in registration order.
+var errorRegistry struct {
+	sync.RWMutex
+	entries []registeredError
+}
+
+type registeredError struct {
+	code string
+	err error
+}
+
+// Wire format of Err{Error}.
+type jsonError struct {
+	Message string `json:"message"`
+	Code string `json:"code,omitempty"`
+}
+
+// Error rebuilt by Err.UnmarshalJSON() whose message differs from the one of
+// its registered sentinel, e.g. because the sentinel was wrapped.
+type decodedError struct {
+	jsonError
+	sentinel error
+}
+
+func (self *decodedError) Error() string {
+	return self.Message
+}
+
+func (self *decodedError) Unwrap() error {
+	return self.sentinel
+}
+
+// Registers a sentinel error under a stable code. Err{Error} is marshalled
+// with the code of the first registered sentinel matching errors.Is(Error, ...)
+// and unmarshalled so that errors.Is() against that sentinel keeps working.
+// Registering a code again replaces its sentinel.
+func RegisterError(code string, err error) {
+	errorRegistry.Lock()
+	defer errorRegistry.Unlock()
+	for i, entry := range errorRegistry.entries {
+		if entry.code == code {
+			errorRegistry.entries[i].err = err
+			return
+		}
+	}
+	errorRegistry.entries = append(errorRegistry.entries, registeredError{code, err})
+}
+
+func errorCode(err error) string {
+	var decoded *decodedError
+	if errors.As(err, &decoded) && decoded.Code != "" {
+		return decoded.Code
+	}
+	errorRegistry.RLock()
+	defer errorRegistry.RUnlock()
+	for _, entry := range errorRegistry.entries {
+		if errors.Is(err, entry.err) {
+			return entry.code
+		}
+	}
+	return ""
+}
+
+func errorFromCode(message, code string) error {
+	if code == "" {
+		return &decodedError{jsonError{message, code}, nil}
+	}
+	errorRegistry.RLock()
+	defer errorRegistry.RUnlock()
+	for _, entry := range errorRegistry.entries {
+		if entry.code == code {
+			if entry.err.Error() == message {
+				return entry.err
+			}
+			return &decodedError{jsonError{message, code}, entry.err}
+		}
+	}
+	return &decodedError{jsonError{message, code}, nil}
+}
+
+// Some{Value} -> JSON encoding of Value
+func (self *Some[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(self.Value)
+}
+
+// JSON encoding of a value -> Some{value}
+// A JSON null leaves Value untouched, like encoding/json does for non-pointer values.
+func (self *Some[T]) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &self.Value)
+}
+
+// None{} -> null
+func (self *None[T]) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// null -> None{}
+// Anything else -> error
+func (self *None[T]) UnmarshalJSON(data []byte) error {
+	if string(data) != "null" {
+		return errors.New("easyerror: None can only be unmarshalled from null")
+	}
+	return nil
+}
+
+// Ok{Value} -> {"ok": Value}
+func (self *Ok[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Ok T `json:"ok"`
+	}{self.Value})
+}
+
+// {"ok": value} -> Ok{value}
+// > {"ok": null} gives the zero value, e.g. for Ok{nil} of a slice or a pointer.
+// Anything else -> error
+func (self *Ok[T]) UnmarshalJSON(data []byte) error {
+	var wire map[string]json.RawMessage
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	value, ok := wire["ok"]
+	if !ok {
+		return errors.New(`easyerror: Ok can only be unmarshalled from {"ok": ...}`)
+	}
+	if string(value) == "null" {
+		var zero T
+		self.Value = zero
+		return nil
+	}
+	return json.Unmarshal(value, &self.Value)
+}
+
+// Err{Error} -> {"err": {"message": Error.Error(), "code": code of Error}}
+// > the code is left out when Error matches no sentinel given to RegisterError().
+// Err{nil} -> error
+func (self *Err[T]) MarshalJSON() ([]byte, error) {
+	if self.Error == nil {
+		return nil, errors.New("easyerror: Err with a nil error can't be marshalled")
+	}
+	return json.Marshal(struct {
+		Err jsonError `json:"err"`
+	}{jsonError{self.Error.Error(), errorCode(self.Error)}})
+}
+
+// {"err": {"message": ..., "code": ...}} -> Err{error rebuilt from the code}
+// Anything else -> error
+func (self *Err[T]) UnmarshalJSON(data []byte) error {
+	var wire struct {
+		Err *jsonError `json:"err"`
+	}
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	if wire.Err == nil {
+		return errors.New(`easyerror: Err can only be unmarshalled from {"err": ...}`)
+	}
+	self.Error = errorFromCode(wire.Err.Message, wire.Err.Code)
+	return nil
+}
@@ -0,0 +1,87 @@
+package easyerror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestOptionJSON(t *testing.T) {
+	data, err := json.Marshal(&Some[int]{123})
+	Assert(err == nil && string(data) == "123")
+	data, err = json.Marshal(&None[int]{})
+	Assert(err == nil && string(data) == "null")
+	data, err = json.Marshal(struct{A, B Option[string]}{&Some[string]{"x"}, &None[string]{}})
+	Assert(err == nil && string(data) == `{"A":"x","B":null}`)
+
+	some := &Some[int]{}
+	Assert(json.Unmarshal([]byte("456"), some) == nil && some.Value == 456)
+	Assert(json.Unmarshal([]byte(`"x"`), some) != nil)
+	none := &None[int]{}
+	Assert(json.Unmarshal([]byte("null"), none) == nil)
+	Assert(json.Unmarshal([]byte("456"), none) != nil)
+}
+
+func TestResultJSON(t *testing.T) {
+	sentinel := errors.New("not found")
+	RegisterError("test.not_found", sentinel)
+
+	data, err := json.Marshal(&Ok[int]{123})
+	Assert(err == nil && string(data) == `{"ok":123}`)
+	data, err = json.Marshal(&Err[int]{errors.New("boom")})
+	Assert(err == nil && string(data) == `{"err":{"message":"boom"}}`)
+	data, err = json.Marshal(&Err[int]{sentinel})
+	Assert(err == nil && string(data) == `{"err":{"message":"not found","code":"test.not_found"}}`)
+	wrapped := fmt.Errorf("user 7: %w", sentinel)
+	data, err = json.Marshal(&Err[int]{wrapped})
+	Assert(err == nil && string(data) == `{"err":{"message":"user 7: not found","code":"test.not_found"}}`)
+
+	ok := &Ok[int]{}
+	Assert(json.Unmarshal([]byte(`{"ok":456}`), ok) == nil && ok.Value == 456)
+	Assert(json.Unmarshal([]byte(`{"err":{"message":"boom"}}`), ok) != nil)
+	Assert(json.Unmarshal([]byte(`{"ok":"x"}`), ok) != nil)
+	Assert(json.Unmarshal([]byte(`[]`), ok) != nil)
+	Assert(json.Unmarshal([]byte(`{}`), ok) != nil)
+
+	// Ok{nil} round-trips through {"ok": null}.
+	nullData, err := json.Marshal(&Ok[[]int]{nil})
+	Assert(err == nil && string(nullData) == `{"ok":null}`)
+	okSlice := &Ok[[]int]{[]int{1}}
+	Assert(json.Unmarshal(nullData, okSlice) == nil && okSlice.Value == nil)
+	okPtr := &Ok[*int]{new(int)}
+	Assert(json.Unmarshal([]byte(`{"ok":null}`), okPtr) == nil && okPtr.Value == nil)
+	_, err = json.Marshal(&Err[int]{nil})
+	Assert(err != nil)
+
+	errRes := &Err[int]{}
+	Assert(json.Unmarshal([]byte(`{"err":{"message":"not found","code":"test.not_found"}}`), errRes) == nil)
+	Assert(errRes.Error == sentinel)
+	Assert(json.Unmarshal(data, errRes) == nil)
+	Assert(errors.Is(errRes.Error, sentinel) && errRes.Error.Error() == "user 7: not found")
+	data, err = json.Marshal(errRes) // The code survives another round trip.
+	Assert(err == nil && string(data) == `{"err":{"message":"user 7: not found","code":"test.not_found"}}`)
+	Assert(json.Unmarshal([]byte(`{"err":{"message":"boom","code":"unknown"}}`), errRes) == nil)
+	Assert(errRes.Error.Error() == "boom" && !errors.Is(errRes.Error, sentinel))
+	data, err = json.Marshal(errRes)
+	Assert(err == nil && string(data) == `{"err":{"message":"boom","code":"unknown"}}`)
+	Assert(json.Unmarshal([]byte(`{"ok":1}`), errRes) != nil)
+	Assert(json.Unmarshal([]byte(`[]`), errRes) != nil)
+}
+
+func TestRegisterErrorAgain(t *testing.T) {
+	old := errors.New("old")
+	replacement := errors.New("replacement")
+	RegisterError("test.other", errors.New("other"))
+	RegisterError("test.replaced", old)
+	RegisterError("test.replaced", replacement)
+
+	errRes := &Err[int]{}
+	Assert(json.Unmarshal([]byte(`{"err":{"message":"replacement","code":"test.replaced"}}`), errRes) == nil)
+	Assert(errRes.Error == replacement)
+	Assert(json.Unmarshal([]byte(`{"err":{"message":"old","code":"test.replaced"}}`), errRes) == nil)
+	Assert(errors.Is(errRes.Error, replacement) && !errors.Is(errRes.Error, old))
+	data, err := json.Marshal(&Err[int]{old}) // old is no longer registered.
+	Assert(err == nil && string(data) == `{"err":{"message":"old"}}`)
+}
@@ -1,5 +1,15 @@
 package easyerror
 
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+)
+
+// Error of Unwrap() on None{}. result.Catch() turns such a panic into Err{ErrNone}.
+var ErrNone = errors.New("easyerror: Unwrap() called on None")
+
 // Implements the Option interface. Holds no value.
 // See the interface for documentation of methods.
 type None[T any] struct {}
@@ -12,8 +22,20 @@ func (self *None[T]) IsNone() bool {
 	return true;
 }
 
+func (self *None[T]) IsSomeAnd(predicate func(T) bool) bool {
+	return false
+}
+
+func (self *None[T]) IsNoneOr(predicate func(T) bool) bool {
+	return true
+}
+
+func (self *None[T]) Expect(msg string) T {
+	panic(&sentinel.Panic{None: true, Err: withStack(fmt.Errorf("%s: %w", msg, ErrNone), 1), PC: sentinel.Caller()})
+}
+
 func (self *None[T]) Unwrap() T {
-	panic(self)
+	panic(&sentinel.Panic{None: true, Err: ErrNone, PC: sentinel.Caller()})
 }
 
 func (self *None[T]) UnwrapOr(defaultValue T) T {
@@ -24,12 +46,17 @@ func (self *None[T]) UnwrapOrElse(defaultFunc func() T) T {
 	return defaultFunc()
 }
 
+func (self *None[T]) UnwrapOrDefault() T {
+	var zero T
+	return zero
+}
+
 func (self *None[T]) OkOr(err error) Result[T] {
-	return &Err[T]{err}
+	return &Err[T]{withStack(err, 1)}
 }
 
 func (self *None[T]) OkOrElse(errorFunc func() error) Result[T] {
-	return &Err[T]{errorFunc()}
+	return &Err[T]{withStack(errorFunc(), 1)}
 }
 
 func (self *None[T]) Filter(filterFunc func(T) bool) Option[T] {
@@ -71,3 +98,11 @@ func (self *None[T]) AndThen(transformFunc func(T) Option[T]) Option[T] {
 func (self *None[T]) OrElse(defaultFunc func() Option[T]) Option[T] {
 	return defaultFunc()
 }
+
+func (self *None[T]) Inspect(inspectFunc func(T)) Option[T] {
+	return self
+}
+
+func (self *None[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {}
+}
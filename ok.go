@@ -1,5 +1,16 @@
 package easyerror
 
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+)
+
+// Error of ExpectErr() on Ok{}. result.Catch() turns such a panic into an
+// Err{} wrapping ErrOk.
+var ErrOk = errors.New("easyerror: ExpectErr() called on Ok")
+
 // Implements the Result interface. Holds some value.
 // See the interface for documentation of methods.
 type Ok[T any] struct {
@@ -14,6 +25,14 @@ func (self *Ok[T]) IsErr() bool {
 	return false
 }
 
+func (self *Ok[T]) IsOkAnd(predicate func(T) bool) bool {
+	return predicate(self.Value)
+}
+
+func (self *Ok[T]) IsErrAnd(predicate func(error) bool) bool {
+	return false
+}
+
 func (self *Ok[T]) Expect(msg string) T {
 	return self.Value
 }
@@ -30,10 +49,18 @@ func (self *Ok[T]) UnwrapOrElse(defaultFunc func() T) T {
 	return self.Value
 }
 
+func (self *Ok[T]) UnwrapOrDefault() T {
+	return self.Value
+}
+
 func (self *Ok[T]) UnwrapErr() error {
 	panic("Can't UnwrapErr on Ok!")
 }
 
+func (self *Ok[T]) ExpectErr(msg string) error {
+	panic(&sentinel.Panic{Err: withStack(fmt.Errorf("%s: %w: %v", msg, ErrOk, self.Value), 1), PC: sentinel.Caller()})
+}
+
 func (self *Ok[T]) Err() Option[error] {
 	return &None[error]{}
 }
@@ -73,3 +100,18 @@ func (self *Ok[T]) AndThen(transformFunc func(T) Result[T]) Result[T] {
 func (self *Ok[T]) OrElse(transformFunc func(error) Result[T]) Result [T] {
 	return self
 }
+
+func (self *Ok[T]) Inspect(inspectFunc func(T)) Result[T] {
+	inspectFunc(self.Value)
+	return self
+}
+
+func (self *Ok[T]) InspectErr(inspectFunc func(error)) Result[T] {
+	return self
+}
+
+func (self *Ok[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		yield(self.Value)
+	}
+}
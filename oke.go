@@ -0,0 +1,112 @@
+package easyerror
+
+import (
+	"fmt"
+	"iter"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+)
+
+// Implements the ResultE interface. Holds some value.
+// See the interface for documentation of methods.
+type OkE[T, E any] struct {
+	Value T
+}
+
+func (self *OkE[T, E]) IsOk() bool {
+	return true
+}
+
+func (self *OkE[T, E]) IsErr() bool {
+	return false
+}
+
+func (self *OkE[T, E]) IsOkAnd(predicate func(T) bool) bool {
+	return predicate(self.Value)
+}
+
+func (self *OkE[T, E]) IsErrAnd(predicate func(E) bool) bool {
+	return false
+}
+
+func (self *OkE[T, E]) Expect(msg string) T {
+	return self.Value
+}
+
+func (self *OkE[T, E]) Unwrap() T {
+	return self.Value
+}
+
+func (self *OkE[T, E]) UnwrapOr(defaultValue T) T {
+	return self.Value
+}
+
+func (self *OkE[T, E]) UnwrapOrElse(defaultFunc func() T) T {
+	return self.Value
+}
+
+func (self *OkE[T, E]) UnwrapOrDefault() T {
+	return self.Value
+}
+
+func (self *OkE[T, E]) UnwrapErr() E {
+	panic("Can't UnwrapErr on Ok!")
+}
+
+func (self *OkE[T, E]) ExpectErr(msg string) E {
+	panic(&sentinel.Panic{Err: withStack(fmt.Errorf("%s: %w: %v", msg, ErrOk, self.Value), 1), PC: sentinel.Caller()})
+}
+
+func (self *OkE[T, E]) Err() Option[E] {
+	return &None[E]{}
+}
+
+func (self *OkE[T, E]) Ok() Option[T] {
+	return &Some[T]{self.Value}
+}
+
+func (self *OkE[T, E]) Map(transformFunc func(T) T) ResultE[T, E] {
+	return &OkE[T, E]{transformFunc(self.Value)}
+}
+
+func (self *OkE[T, E]) MapErr(transformFunc func(E) E) ResultE[T, E] {
+	return self
+}
+
+func (self *OkE[T, E]) MapOr(defaultValue T, transformFunc func(T) T) T {
+	return transformFunc(self.Value)
+}
+
+func (self *OkE[T, E]) MapOrElse(defaultFunc func() T, transformFunc func(T) T) T {
+	return transformFunc(self.Value)
+}
+
+func (self *OkE[T, E]) And(second ResultE[T, E]) ResultE[T, E] {
+	return second
+}
+
+func (self *OkE[T, E]) Or(second ResultE[T, E]) ResultE[T, E] {
+	return self
+}
+
+func (self *OkE[T, E]) AndThen(transformFunc func(T) ResultE[T, E]) ResultE[T, E] {
+	return transformFunc(self.Value)
+}
+
+func (self *OkE[T, E]) OrElse(transformFunc func(E) ResultE[T, E]) ResultE[T, E] {
+	return self
+}
+
+func (self *OkE[T, E]) Inspect(inspectFunc func(T)) ResultE[T, E] {
+	inspectFunc(self.Value)
+	return self
+}
+
+func (self *OkE[T, E]) InspectErr(inspectFunc func(E)) ResultE[T, E] {
+	return self
+}
+
+func (self *OkE[T, E]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		yield(self.Value)
+	}
+}
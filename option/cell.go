@@ -0,0 +1,118 @@
+package option
+
+import (
+	"sync"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// Mutable slot holding an Option. The zero value holds None{}.
+// Not safe for concurrent use; see SyncCell.
+type Cell[T any] struct {
+	opt Option[T] // nil means None{}.
+}
+
+func NewCell[T any](opt Option[T]) *Cell[T] {
+	return &Cell[T]{opt}
+}
+
+// Returns the held Option.
+func (self *Cell[T]) Get() Option[T] {
+	if self.opt == nil {
+		return &None[T]{}
+	}
+	return self.opt
+}
+
+// Holds the given Option from now on.
+func (self *Cell[T]) Set(opt Option[T]) {
+	self.opt = opt
+}
+
+// Holds None{} from now on and returns the previously held Option.
+func (self *Cell[T]) Take() Option[T] {
+	prev := self.Get()
+	self.opt = nil
+	return prev
+}
+
+// Holds Some{value} from now on and returns the previously held Option.
+func (self *Cell[T]) Replace(value T) Option[T] {
+	prev := self.Get()
+	self.opt = &Some[T]{value}
+	return prev
+}
+
+// Holds Some{value} from now on and returns value.
+func (self *Cell[T]) Insert(value T) T {
+	self.opt = &Some[T]{value}
+	return value
+}
+
+// Some{Value} -> Value
+// None{} -> holds Some{value} from now on and returns value
+func (self *Cell[T]) GetOrInsert(value T) T {
+	return self.GetOrInsertWith(func() T {return value})
+}
+
+// Some{Value} -> Value
+// None{} -> holds Some{insertFunc()} from now on and returns the inserted value
+func (self *Cell[T]) GetOrInsertWith(insertFunc func() T) T {
+	if opt := self.Get(); opt.IsSome() {
+		return opt.Unwrap()
+	}
+	return self.Insert(insertFunc())
+}
+
+// Same as Cell, guarded by a mutex so that it can be shared across goroutines.
+// Every method is atomic. The zero value holds None{}.
+type SyncCell[T any] struct {
+	mutex sync.Mutex
+	cell Cell[T]
+}
+
+func NewSyncCell[T any](opt Option[T]) *SyncCell[T] {
+	return &SyncCell[T]{cell: Cell[T]{opt}}
+}
+
+func (self *SyncCell[T]) Get() Option[T] {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.cell.Get()
+}
+
+func (self *SyncCell[T]) Set(opt Option[T]) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	self.cell.Set(opt)
+}
+
+func (self *SyncCell[T]) Take() Option[T] {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.cell.Take()
+}
+
+func (self *SyncCell[T]) Replace(value T) Option[T] {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.cell.Replace(value)
+}
+
+func (self *SyncCell[T]) Insert(value T) T {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.cell.Insert(value)
+}
+
+func (self *SyncCell[T]) GetOrInsert(value T) T {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.cell.GetOrInsert(value)
+}
+
+// insertFunc runs with the mutex held, so concurrent callers never insert twice.
+func (self *SyncCell[T]) GetOrInsertWith(insertFunc func() T) T {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.cell.GetOrInsertWith(insertFunc)
+}
@@ -0,0 +1,53 @@
+package option
+
+import (
+	"sync"
+	"testing"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestCell(t *testing.T) {
+	var cell Cell[int]
+	Assert(cell.Get().IsNone())
+	Assert(cell.Take().IsNone())
+	Assert(cell.Replace(1).IsNone())
+	Assert(cell.Get().Unwrap() == 1)
+	Assert(cell.Replace(2).Unwrap() == 1)
+	Assert(cell.Take().Unwrap() == 2)
+	Assert(cell.Get().IsNone())
+	Assert(cell.Insert(3) == 3 && cell.Get().Unwrap() == 3)
+	Assert(cell.GetOrInsertWith(func() int {panic("not called")}) == 3)
+	Assert(cell.GetOrInsert(4) == 3)
+	cell.Set(&None[int]{})
+	Assert(cell.GetOrInsert(4) == 4)
+	cell.Set(nil)
+	Assert(cell.GetOrInsertWith(func() int {return 5}) == 5)
+	Assert(NewCell[int](&Some[int]{6}).Get().Unwrap() == 6)
+
+	var syncCell SyncCell[int]
+	Assert(syncCell.Get().IsNone() && syncCell.Take().IsNone())
+	Assert(syncCell.Replace(1).IsNone() && syncCell.Insert(2) == 2)
+	Assert(syncCell.GetOrInsert(3) == 2 && syncCell.Take().Unwrap() == 2)
+	syncCell.Set(&Some[int]{4})
+	Assert(NewSyncCell[int](&None[int]{}).Get().IsNone())
+
+	var mutex sync.Mutex
+	calls := 0
+	syncCell.Set(&None[int]{})
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Assert(syncCell.GetOrInsertWith(func() int {
+				mutex.Lock()
+				defer mutex.Unlock()
+				calls++
+				return 7
+			}) == 7)
+		}()
+	}
+	wg.Wait()
+	Assert(calls == 1)
+}
@@ -0,0 +1,90 @@
+package option
+
+import (
+	"encoding/json"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// Value type holding an optional T, usable as a struct field where the
+// Option interface itself can't be unmarshalled into. The zero value is None{}.
+// Encodes as null when None{} and as the value otherwise, both in JSON and
+// in SQL. Field names follow database/sql's Null[T].
+type Nullable[T any] struct {
+	V T
+	Valid bool
+}
+
+// Some{Value} -> Nullable{Value, true}
+// None{} -> Nullable{zero value, false}
+func ToNullable[T any](input Option[T]) Nullable[T] {
+	if input.IsSome() {
+		return Nullable[T]{input.Unwrap(), true}
+	}
+	return Nullable[T]{}
+}
+
+// Nullable{V, true} -> Some{V}
+// Nullable{_, false} -> None{}
+func (self Nullable[T]) Option() Option[T] {
+	if self.Valid {
+		return &Some[T]{self.V}
+	}
+	return &None[T]{}
+}
+
+func (self Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !self.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(self.V)
+}
+
+func (self *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*self = Nullable[T]{}
+		return nil
+	}
+	if err := json.Unmarshal(data, &self.V); err != nil {
+		return err
+	}
+	self.Valid = true
+	return nil
+}
+
+// Tri-state struct field for PATCH-style JSON bodies. Tells a missing key
+// (Present is false) apart from an explicit null (Present is true, Valid is
+// false) and a value (both true). Tag the field with `json:",omitzero"` so
+// that a missing value is left out when marshalling.
+type Patch[T any] struct {
+	V T
+	Valid bool
+	Present bool
+}
+
+// Missing key -> None{}
+// null -> Some{None{}}
+// value -> Some{Some{value}}
+func (self Patch[T]) Option() Option[Option[T]] {
+	if !self.Present {
+		return &None[Option[T]]{}
+	}
+	return &Some[Option[T]]{Nullable[T]{self.V, self.Valid}.Option()}
+}
+
+// Reports whether the key was missing. Used by the omitzero JSON option.
+func (self Patch[T]) IsZero() bool {
+	return !self.Present
+}
+
+func (self Patch[T]) MarshalJSON() ([]byte, error) {
+	return Nullable[T]{self.V, self.Valid}.MarshalJSON()
+}
+
+func (self *Patch[T]) UnmarshalJSON(data []byte) error {
+	var nullable Nullable[T]
+	if err := nullable.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*self = Patch[T]{nullable.V, nullable.Valid, true}
+	return nil
+}
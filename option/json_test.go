@@ -0,0 +1,47 @@
+package option
+
+import (
+	"encoding/json"
+	"testing"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestNullable(t *testing.T) {
+	type body struct {
+		Name Nullable[string] `json:"name"`
+	}
+	var b body
+	Assert(b.Name.Option().IsNone()) // The zero value is None.
+	Assert(json.Unmarshal([]byte(`{"name":"x"}`), &b) == nil)
+	Assert(b.Name.Option().Unwrap() == "x")
+	Assert(json.Unmarshal([]byte(`{"name":null}`), &b) == nil)
+	Assert(b.Name.Option().IsNone())
+	Assert(json.Unmarshal([]byte(`{"name":1}`), &b) != nil)
+	data, err := json.Marshal(body{ToNullable[string](&Some[string]{"x"})})
+	Assert(err == nil && string(data) == `{"name":"x"}`)
+	data, err = json.Marshal(body{ToNullable[string](&None[string]{})})
+	Assert(err == nil && string(data) == `{"name":null}`)
+}
+
+func TestPatch(t *testing.T) {
+	type body struct {
+		Name Patch[string] `json:"name,omitzero"`
+	}
+	var b body
+	Assert(json.Unmarshal([]byte(`{}`), &b) == nil)
+	Assert(!b.Name.Present && b.Name.Option().IsNone())
+	Assert(json.Unmarshal([]byte(`{"name":null}`), &b) == nil)
+	Assert(b.Name.Present && b.Name.Option().Unwrap().IsNone())
+	b = body{}
+	Assert(json.Unmarshal([]byte(`{"name":"x"}`), &b) == nil)
+	Assert(b.Name.Present && b.Name.Option().Unwrap().Unwrap() == "x")
+	Assert(json.Unmarshal([]byte(`{"name":1}`), &b) != nil)
+
+	data, err := json.Marshal(body{})
+	Assert(err == nil && string(data) == `{}`)
+	data, err = json.Marshal(body{Patch[string]{Present: true}})
+	Assert(err == nil && string(data) == `{"name":null}`)
+	data, err = json.Marshal(body{Patch[string]{"x", true, true}})
+	Assert(err == nil && string(data) == `{"name":"x"}`)
+}
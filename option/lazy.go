@@ -0,0 +1,36 @@
+package option
+
+import (
+	"sync"
+	"sync/atomic"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// Option computed by a function on first use. Created by NewLazy(). It is safe
+// for concurrent use: the function runs once at a time and the callers of
+// Get() meanwhile wait for its Option.
+type Lazy[T any] struct {
+	mutex sync.Mutex
+	lazyFunc func() Option[T]
+	done atomic.Bool
+	opt Option[T]
+}
+
+func NewLazy[T any](lazyFunc func() Option[T]) *Lazy[T] {
+	return &Lazy[T]{lazyFunc: lazyFunc}
+}
+
+// Returns the cached Option, Some or None, calling the function first if there is none.
+// A panic in the function is re-raised and the next Get() calls it again.
+func (self *Lazy[T]) Get() Option[T] {
+	if self.done.Load() {
+		return self.opt
+	}
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	if !self.done.Load() {
+		self.opt = self.lazyFunc()
+		self.done.Store(true)
+	}
+	return self.opt
+}
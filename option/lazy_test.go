@@ -0,0 +1,46 @@
+package option
+
+import (
+	"sync"
+	"testing"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	none := NewLazy[int](func() Option[int] {
+		calls++
+		return &None[int]{}
+	})
+	Assert(none.Get().IsNone() && none.Get().IsNone() && calls == 1)
+
+	var mutex sync.Mutex
+	calls = 0
+	some := NewLazy[int](func() Option[int] {
+		mutex.Lock()
+		defer mutex.Unlock()
+		calls++
+		return &Some[int]{123}
+	})
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Assert(some.Get().Unwrap() == 123)
+		}()
+	}
+	wg.Wait()
+	Assert(calls == 1)
+
+	panicking := NewLazy[int](func() Option[int] {
+		calls++
+		if calls == 2 {
+			panic("boom")
+		}
+		return &Some[int]{calls}
+	})
+	Assert(Recover[string](func() {panicking.Get()}) == "boom")
+	Assert(panicking.Get().Unwrap() == 3)
+}
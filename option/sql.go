@@ -0,0 +1,24 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// NULL -> Nullable{zero value, false}
+// Anything else -> Nullable{value converted to T, true}
+// > conversions follow the rules of database/sql's Rows.Scan().
+func (self *Nullable[T]) Scan(src any) error {
+	var null sql.Null[T]
+	if err := null.Scan(src); err != nil {
+		return err
+	}
+	*self = Nullable[T]{null.V, null.Valid}
+	return nil
+}
+
+// Nullable{V, true} -> V
+// Nullable{_, false} -> NULL
+func (self Nullable[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: self.V, Valid: self.Valid}.Value()
+}
@@ -0,0 +1,83 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+// In-memory database/sql driver. Every query returns fakeRows and every
+// statement execution records its arguments in fakeArgs.
+var fakeRows [][]driver.Value
+var fakeArgs []driver.Value
+
+type fakeDriver struct{}
+type fakeConn struct{}
+type fakeStmt struct{}
+type fakeResultRows struct {
+	next int
+}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {return fakeConn{}, nil}
+func (fakeConn) Prepare(query string) (driver.Stmt, error) {return fakeStmt{}, nil}
+func (fakeConn) Close() error {return nil}
+func (fakeConn) Begin() (driver.Tx, error) {return nil, errors.New("not supported")}
+func (fakeStmt) Close() error {return nil}
+func (fakeStmt) NumInput() int {return -1}
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	fakeArgs = args
+	return driver.RowsAffected(1), nil
+}
+func (fakeStmt) Query(args []driver.Value) (driver.Rows, error) {return &fakeResultRows{}, nil}
+func (*fakeResultRows) Columns() []string {return []string{"name", "age"}}
+func (*fakeResultRows) Close() error {return nil}
+func (self *fakeResultRows) Next(dest []driver.Value) error {
+	if self.next == len(fakeRows) {
+		return io.EOF
+	}
+	copy(dest, fakeRows[self.next])
+	self.next++
+	return nil
+}
+
+func init() {
+	sql.Register("easyerror-fake", fakeDriver{})
+}
+
+func TestNullableSQL(t *testing.T) {
+	db, err := sql.Open("easyerror-fake", "")
+	Assert(err == nil)
+	defer db.Close()
+
+	fakeRows = [][]driver.Value{{"alice", int64(30)}, {nil, nil}, {[]byte("bob"), "41"}}
+	rows, err := db.Query("SELECT name, age FROM people")
+	Assert(err == nil)
+	var names []Nullable[string]
+	var ages []Nullable[int]
+	for rows.Next() {
+		var name Nullable[string]
+		var age Nullable[int]
+		Assert(rows.Scan(&name, &age) == nil)
+		names = append(names, name)
+		ages = append(ages, age)
+	}
+	Assert(rows.Err() == nil)
+	Assert(names[0].Option().Unwrap() == "alice" && ages[0].Option().Unwrap() == 30)
+	Assert(names[1].Option().IsNone() && ages[1].Option().IsNone())
+	Assert(names[2].Option().Unwrap() == "bob" && ages[2].Option().Unwrap() == 41)
+
+	fakeRows = [][]driver.Value{{"carol", "not a number"}}
+	var name Nullable[string]
+	var age Nullable[int]
+	Assert(db.QueryRow("SELECT name, age FROM people").Scan(&name, &age) != nil)
+
+	_, err = db.Exec("INSERT INTO people VALUES (?, ?)", Nullable[string]{"dave", true}, Nullable[int]{})
+	Assert(err == nil)
+	Assert(fakeArgs[0] == "dave" && fakeArgs[1] == nil)
+	_, err = db.Exec("INSERT INTO people VALUES (?, ?)", Nullable[string]{}, Nullable[int]{41, true})
+	Assert(err == nil)
+	Assert(fakeArgs[0] == nil && fakeArgs[1] == int64(41))
+}
@@ -1,8 +1,10 @@
 package option
 
 import (
-	"reflect"
+	"errors"
+	"fmt"
 	. "github.com/Sh1kharGupta/easyerror"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
 )
 
 // Returned by Zip().
@@ -11,24 +13,31 @@ type Pair[T1, T2 any] struct {
 	Second T2
 }
 
-// Can catch panics by Unwrap() on None{}. Please see README for usage.
+// Can catch panics by Unwrap() or Expect() on None{}. Please see README for usage.
+// Panics by Unwrap() or Expect() on Err{Error}, or on ErrE{Error} whose Error is
+// an error, are caught as well and give None{}.
+// Any other panic is re-raised with its original value, including the ones by
+// ExpectErr() on Ok{Value} and by ErrE{Error} whose Error is not an error.
 func Catch[T any](ret *Option[T]) {
 	r := recover()
 	if r == nil {
 		return
 	}
-	vp := reflect.ValueOf(r)
-	if vp.Kind() != reflect.Pointer {
-		panic(r)
-	}
-	v := vp.Elem()
-	// TODO: improve this condition to be more stringent.
-	if v.Kind() != reflect.Struct || v.NumField() != 0 {
+	if p, ok := r.(*sentinel.Panic); !ok || !fromNoneOrErr(p) {
 		panic(r)
 	}
 	*ret = &None[T]{}
 }
 
+// Reports whether the sentinel was raised by a None{} or an Err{}.
+func fromNoneOrErr(p *sentinel.Panic) bool {
+	if p.None || p.Err == nil {
+		return true
+	}
+	err, ok := p.Err.(error)
+	return ok && !errors.Is(err, ErrOk)
+}
+
 // Some{Ok{Value}} -> Ok{Some{Value}}
 // Some{Err{Error}} -> Err{Error}
 // None{} -> Ok{None{}}
@@ -50,6 +59,70 @@ func Flatten[T any](input_ Option[Option[T]]) Option[T] {
 	return input.UnwrapOr(&None[T]{})
 }
 
+// [Some{Value1}, Some{Value2}, ...] -> Some{[Value1, Value2, ...]}
+// Any None{} -> None{}
+func Sequence[T any](inputs []Option[T]) Option[[]T] {
+	values := make([]T, 0, len(inputs))
+	for _, input := range inputs {
+		if input.IsNone() {
+			return &None[[]T]{}
+		}
+		values = append(values, input.Unwrap())
+	}
+	return &Some[[]T]{values}
+}
+
+// Same as Sequence() on the results of the given function applied to every input.
+// The function is not called on the inputs after the first None{}.
+func Traverse[T1, T2 any](inputs []T1, transformFunc func(T1) Option[T2]) Option[[]T2] {
+	values := make([]T2, 0, len(inputs))
+	for _, input := range inputs {
+		output := transformFunc(input)
+		if output.IsNone() {
+			return &None[[]T2]{}
+		}
+		values = append(values, output.Unwrap())
+	}
+	return &Some[[]T2]{values}
+}
+
+// [Some{Value1}, None{}, Some{Value2}, ...] -> [Value1, Value2, ...]
+func Compact[T any](inputs []Option[T]) []T {
+	var values []T
+	for _, input := range inputs {
+		if input.IsSome() {
+			values = append(values, input.Unwrap())
+		}
+	}
+	return values
+}
+
+// Same as Compact() except that it also returns the positions of the None{} inputs.
+func CompactIndexed[T any](inputs []Option[T]) ([]T, []int) {
+	var values []T
+	var indexes []int
+	for i, input := range inputs {
+		if input.IsSome() {
+			values = append(values, input.Unwrap())
+		} else {
+			indexes = append(indexes, i)
+		}
+	}
+	return values, indexes
+}
+
+// Same as Compact() on the results of the given function applied to every input.
+func FilterMap[T1, T2 any](inputs []T1, transformFunc func(T1) Option[T2]) []T2 {
+	var values []T2
+	for _, input := range inputs {
+		output := transformFunc(input)
+		if output.IsSome() {
+			values = append(values, output.Unwrap())
+		}
+	}
+	return values
+}
+
 // Similar to the bound Map() method except this one can work with multiple types.
 // Please see the `Option` interface for further documentation.
 func Map[T1, T2 any](input Option[T1], transformFunc func(T1) T2) Option[T2] {
@@ -86,6 +159,16 @@ func Zip[T1, T2 any](first Option[T1], second Option[T2]) Option[Pair[T1, T2]] {
 	return &None[Pair[T1, T2]]{}
 }
 
+// Some{Pair{Value1, Value2}} -> Some{Value1}, Some{Value2}
+// None{} -> None{}, None{}
+func Unzip[T1, T2 any](input Option[Pair[T1, T2]]) (Option[T1], Option[T2]) {
+	if input.IsSome() {
+		pair := input.Unwrap()
+		return &Some[T1]{pair.First}, &Some[T2]{pair.Second}
+	}
+	return &None[T1]{}, &None[T2]{}
+}
+
 // Similar to the bound ZipWith() method except this one can work with multiple types.
 // Please see the `Option` interface for further documentation.
 func ZipWith[T1, T2, T3 any](first Option[T1], second Option[T2], transformFunc func(T1, T2) T3) Option[T3] {
@@ -124,3 +207,43 @@ func Convert[T any](value T, err error) Option[T] {
 	}
 	return &None[T]{}
 }
+
+// Returns the Option pointed to and leaves None{} in its place.
+func Take[T any](input *Option[T]) Option[T] {
+	ret := *input
+	*input = &None[T]{}
+	return ret
+}
+
+// Some{Value} -> Value == given value
+// None{} -> false
+func Contains[T comparable](input Option[T], value T) bool {
+	return input.IsSomeAnd(func(x T) bool {return x == value})
+}
+
+// Same as the bound OkOr() method, giving a ResultE with a typed error.
+// Some{Value} -> OkE{Value}
+// None{} -> ErrE{given error}
+func OkOrE[T, E any](input Option[T], err E) ResultE[T, E] {
+	if input.IsSome() {
+		return &OkE[T, E]{input.Unwrap()}
+	}
+	return &ErrE[T, E]{err}
+}
+
+// Same as the bound OkOrElse() method, giving a ResultE with a typed error.
+// Some{Value} -> OkE{Value}
+// None{} -> ErrE{return value of given function}
+func OkOrElseE[T, E any](input Option[T], errorFunc func() E) ResultE[T, E] {
+	if input.IsSome() {
+		return &OkE[T, E]{input.Unwrap()}
+	}
+	return &ErrE[T, E]{errorFunc()}
+}
+
+// Some{Value} -> Ok{Value}
+// None{} -> Err{fmt.Errorf(format, args...)}
+// > the error is only formatted for None{}.
+func OkOrErrorf[T any](input Option[T], format string, args ...any) Result[T] {
+	return input.OkOrElse(func() error {return fmt.Errorf(format, args...)})
+}
@@ -2,8 +2,10 @@ package option
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 	. "github.com/Sh1kharGupta/easyerror"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
 	. "github.com/Sh1kharGupta/easyerror/test_utils"
 )
 
@@ -71,3 +73,106 @@ func TestUnbound(t *testing.T) {
 	Assert(Convert[int](func4(0)).Unwrap() == 123)
 	Assert(Convert[int](func4(1)).IsNone())
 }
+
+func TestParity(t *testing.T) {
+	myError := errors.New("MyError")
+	some := &Some[int]{123}
+	none := &None[int]{}
+	for _, first := range []Option[int]{some, none} {
+		for _, second := range []Option[string]{&Some[string]{"abc"}, &None[string]{}} {
+			// Unzip() undoes Zip() when both are Some{}; otherwise both are None{}.
+			unzipped1, unzipped2 := Unzip[int, string](Zip[int, string](first, second))
+			if first.IsSome() && second.IsSome() {
+				Assert(unzipped1.Unwrap() == first.Unwrap() && unzipped2.Unwrap() == second.Unwrap())
+			} else {
+				Assert(unzipped1.IsNone() && unzipped2.IsNone())
+			}
+		}
+		Assert(Contains[int](first, 123) == first.IsSomeAnd(func(x int) bool {return x == 123}))
+		Assert(!Contains[int](first, 456))
+
+		opt := first
+		Assert(Take[int](&opt) == first && opt.IsNone())
+
+		resE := OkOrE[int, string](first, "MyError")
+		Assert(resE.IsOk() == first.IsSome() && resE.UnwrapOr(456) == first.UnwrapOr(456))
+		resE = OkOrElseE[int, string](first, func() string {return "MyError"})
+		Assert(resE.IsOk() == first.IsSome() && resE.UnwrapOr(456) == first.UnwrapOr(456))
+	}
+	Assert(OkOrE[int, string](none, "MyError").UnwrapErr() == "MyError")
+	Assert(OkOrErrorf[int](some, "id %d", 1).Unwrap() == 123)
+	err := OkOrErrorf[int](none, "id %d: %w", 1, myError).UnwrapErr()
+	Assert(err.Error() == "id 1: MyError" && errors.Is(err, myError))
+	called := false
+	OkOrElseE[int, string](some, func() string {called = true; return ""})
+	Assert(!called)
+}
+
+func TestSequence(t *testing.T) {
+	some := &Some[int]{1}
+	some2 := &Some[int]{2}
+	none := &None[int]{}
+	Assert(reflect.DeepEqual(Sequence[int]([]Option[int]{some, some2}).Unwrap(), []int{1, 2}))
+	Assert(reflect.DeepEqual(Sequence[int](nil).Unwrap(), []int{}))
+	Assert(Sequence[int]([]Option[int]{some, none}).IsNone())
+	calls := 0
+	func1 := func(x int) Option[string] {
+		calls++
+		if x < 0 {
+			return &None[string]{}
+		}
+		return &Some[string]{string(rune('a' + x))}
+	}
+	Assert(reflect.DeepEqual(Traverse[int, string]([]int{0, 1}, func1).Unwrap(), []string{"a", "b"}))
+	calls = 0
+	Assert(Traverse[int, string]([]int{-1, 0}, func1).IsNone())
+	Assert(calls == 1) // Stops at the first None.
+}
+
+func TestCompact(t *testing.T) {
+	inputs := []Option[int]{&Some[int]{1}, &None[int]{}, &Some[int]{2}}
+	Assert(reflect.DeepEqual(Compact[int](inputs), []int{1, 2}))
+	Assert(Compact[int](nil) == nil)
+	values, indexes := CompactIndexed[int](inputs)
+	Assert(reflect.DeepEqual(values, []int{1, 2}))
+	Assert(reflect.DeepEqual(indexes, []int{1}))
+	func1 := func(x int) Option[string] {
+		if x < 0 {
+			return &None[string]{}
+		}
+		return &Some[string]{string(rune('a' + x))}
+	}
+	Assert(reflect.DeepEqual(FilterMap[int, string]([]int{0, -1, 1}, func1), []string{"a", "b"}))
+}
+
+func TestCatchForeignPanics(t *testing.T) {
+	foreign := &struct{}{}
+	func1 := func(condition int) (ret Option[int]) {
+		defer Catch[int](&ret)
+		switch condition {
+		case 0:
+			panic(foreign)
+		case 1:
+			(&Err[int]{errors.New("MyError")}).Unwrap()
+		case 2:
+			SomeV(1).Filter(func(int) bool {return false}).Unwrap()
+		case 3:
+			(&None[int]{}).Expect("missing")
+		case 4:
+			(&ErrE[int, error]{errors.New("MyError")}).Unwrap()
+		case 5:
+			(&Ok[int]{1}).ExpectErr("unexpected")
+		case 6:
+			(&ErrE[int, string]{"MyError"}).Unwrap()
+		}
+		return &Some[int]{123}
+	}
+	Assert(Recover[*struct{}](func() {func1(0)}) == foreign) // Re-raised as is.
+	Assert(func1(1).IsNone()) // Err{} panics are caught too.
+	Assert(func1(2).IsNone())
+	Assert(func1(3).IsNone())
+	Assert(func1(4).IsNone())
+	// Neither comes from a None{} or an Err{}.
+	Assert(errors.Is(Recover[*sentinel.Panic](func() {func1(5)}).Err.(error), ErrOk))
+	Assert(Recover[*sentinel.Panic](func() {func1(6)}).Err == "MyError")
+}
@@ -2,7 +2,9 @@ package easyerror
 
 import (
 	"errors"
+	"slices"
 	"testing"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
 	. "github.com/Sh1kharGupta/easyerror/test_utils"
 )
 
@@ -15,7 +17,7 @@ func TestOption(t *testing.T) {
 	Assert(!some.IsNone())
 	Assert(none.IsNone())
 	Assert(some.Unwrap() == 123)
-	Assert(Recover[*None[int]](func() {none.Unwrap()}) == none)
+	Assert(Recover[*sentinel.Panic](func() {none.Unwrap()}).None)
 	Assert(some.UnwrapOr(456) == 123)
 	Assert(none.UnwrapOr(456) == 456)
 	Assert(some.UnwrapOrElse(func() int {return 456}) == 123)
@@ -39,7 +41,7 @@ func TestOption(t *testing.T) {
 	some2 := &Some[int]{456}
 	none2 := &None[int]{}
 	Assert(some.ZipWith(some2, func2).Unwrap() == 579)
-	Assert(some.ZipWith(none2, func2) == none)
+	Assert(some.ZipWith(none2, func2) == none2)
 	Assert(none.ZipWith(some2, func2) == none)
 	Assert(none.ZipWith(none2, func2) == none)
 	Assert(some.And(some2) == some2)
@@ -63,3 +65,39 @@ func TestOption(t *testing.T) {
 	Assert(none.OrElse(func() Option[int] {return some2}) == some2)
 	Assert(none.OrElse(func() Option[int] {return none2}) == none2)
 }
+
+func TestOptionIter(t *testing.T) {
+	values := []int{}
+	for value := range (&Some[int]{123}).Iter() {
+		values = append(values, value)
+	}
+	for value := range (&None[int]{}).Iter() {
+		values = append(values, value)
+	}
+	Assert(len(values) == 1 && values[0] == 123)
+}
+
+// Checks the methods matching Rust's is_some_and, is_none_or, expect,
+// unwrap_or_default and inspect against their definitions.
+func TestOptionLaws(t *testing.T) {
+	even := func(x int) bool {return x % 2 == 0}
+	odd := func(x int) bool {return !even(x)}
+	for _, opt := range []Option[int]{&Some[int]{2}, &Some[int]{3}, &None[int]{}} {
+		for _, predicate := range []func(int) bool{even, odd} {
+			Assert(opt.IsSomeAnd(predicate) == opt.Filter(predicate).IsSome())
+			Assert(opt.IsNoneOr(predicate) == !opt.IsSomeAnd(func(x int) bool {return !predicate(x)}))
+		}
+		Assert(opt.UnwrapOrDefault() == opt.UnwrapOr(0))
+		var inspected []int
+		Assert(opt.Inspect(func(x int) {inspected = append(inspected, x)}) == opt)
+		Assert(len(inspected) == len(slices.Collect(opt.Iter())) && (opt.IsNone() || inspected[0] == opt.Unwrap()))
+		if opt.IsSome() {
+			Assert(opt.Expect("msg") == opt.Unwrap())
+		} else {
+			p := Recover[*sentinel.Panic](func() {opt.Expect("msg")})
+			Assert(p.None && errors.Is(p.Err.(error), ErrNone))
+			Assert(p.Err.(error).Error() == "msg: " + ErrNone.Error())
+		}
+	}
+	Assert((&None[*int]{}).UnwrapOrDefault() == nil)
+}
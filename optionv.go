@@ -0,0 +1,197 @@
+package easyerror
+
+import (
+	"fmt"
+	"iter"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+)
+
+// Allocation-free alternative to the Option interface. Has the same methods
+// as Option but is passed around by value, so unlike Some{} and None{} it
+// does not escape to the heap. The zero value is NoneV().
+// See the Option interface for documentation of methods.
+type OptionV[T any] struct {
+	value T
+	ok bool
+}
+
+// Returns an OptionV holding the given value.
+func SomeV[T any](value T) OptionV[T] {
+	return OptionV[T]{value, true}
+}
+
+// Returns an OptionV holding no value.
+func NoneV[T any]() OptionV[T] {
+	return OptionV[T]{}
+}
+
+// Some{Value} -> SomeV(Value)
+// None{} -> NoneV()
+func ToOptionV[T any](input Option[T]) OptionV[T] {
+	if input.IsSome() {
+		return OptionV[T]{input.Unwrap(), true}
+	}
+	return OptionV[T]{}
+}
+
+// SomeV(Value) -> Some{Value}
+// NoneV() -> None{}
+func (self OptionV[T]) Option() Option[T] {
+	if self.ok {
+		return &Some[T]{self.value}
+	}
+	return &None[T]{}
+}
+
+func (self OptionV[T]) IsSome() bool {
+	return self.ok
+}
+
+func (self OptionV[T]) IsNone() bool {
+	return !self.ok
+}
+
+func (self OptionV[T]) IsSomeAnd(predicate func(T) bool) bool {
+	return self.ok && predicate(self.value)
+}
+
+func (self OptionV[T]) IsNoneOr(predicate func(T) bool) bool {
+	return !self.ok || predicate(self.value)
+}
+
+// Panics the same way as None.Expect() so that option.Catch() works.
+func (self OptionV[T]) Expect(msg string) T {
+	if !self.ok {
+		panic(&sentinel.Panic{None: true, Err: withStack(fmt.Errorf("%s: %w", msg, ErrNone), 1), PC: sentinel.Caller()})
+	}
+	return self.value
+}
+
+// Panics the same way as None.Unwrap() so that option.Catch() works.
+func (self OptionV[T]) Unwrap() T {
+	if !self.ok {
+		panic(&sentinel.Panic{None: true, Err: ErrNone, PC: sentinel.Caller()})
+	}
+	return self.value
+}
+
+func (self OptionV[T]) UnwrapOr(defaultValue T) T {
+	if self.ok {
+		return self.value
+	}
+	return defaultValue
+}
+
+func (self OptionV[T]) UnwrapOrElse(defaultFunc func() T) T {
+	if self.ok {
+		return self.value
+	}
+	return defaultFunc()
+}
+
+// The zero value of T is what NoneV() holds.
+func (self OptionV[T]) UnwrapOrDefault() T {
+	return self.value
+}
+
+func (self OptionV[T]) OkOr(err error) ResultV[T] {
+	if self.ok {
+		return ResultV[T]{self.value, nil, false}
+	}
+	return ResultV[T]{err: withStack(err, 1), isErr: true}
+}
+
+func (self OptionV[T]) OkOrElse(errorFunc func() error) ResultV[T] {
+	if self.ok {
+		return ResultV[T]{self.value, nil, false}
+	}
+	return ResultV[T]{err: withStack(errorFunc(), 1), isErr: true}
+}
+
+func (self OptionV[T]) Filter(filterFunc func(T) bool) OptionV[T] {
+	if self.ok && filterFunc(self.value) {
+		return self
+	}
+	return OptionV[T]{}
+}
+
+func (self OptionV[T]) Map(transformFunc func(T) T) OptionV[T] {
+	if self.ok {
+		return OptionV[T]{transformFunc(self.value), true}
+	}
+	return self
+}
+
+func (self OptionV[T]) MapOr(defaultValue T, transformFunc func(T) T) T {
+	if self.ok {
+		return transformFunc(self.value)
+	}
+	return defaultValue
+}
+
+func (self OptionV[T]) MapOrElse(defaultFunc func() T, transformFunc func(T) T) T {
+	if self.ok {
+		return transformFunc(self.value)
+	}
+	return defaultFunc()
+}
+
+func (self OptionV[T]) ZipWith(second OptionV[T], transformFunc func(T, T) T) OptionV[T] {
+	if self.ok && second.ok {
+		return OptionV[T]{transformFunc(self.value, second.value), true}
+	}
+	return OptionV[T]{}
+}
+
+func (self OptionV[T]) And(second OptionV[T]) OptionV[T] {
+	if self.ok {
+		return second
+	}
+	return self
+}
+
+func (self OptionV[T]) Or(second OptionV[T]) OptionV[T] {
+	if self.ok {
+		return self
+	}
+	return second
+}
+
+func (self OptionV[T]) Xor(second OptionV[T]) OptionV[T] {
+	if self.ok && second.ok {
+		return OptionV[T]{}
+	}
+	if self.ok {
+		return self
+	}
+	return second
+}
+
+func (self OptionV[T]) AndThen(transformFunc func(T) OptionV[T]) OptionV[T] {
+	if self.ok {
+		return transformFunc(self.value)
+	}
+	return self
+}
+
+func (self OptionV[T]) OrElse(defaultFunc func() OptionV[T]) OptionV[T] {
+	if self.ok {
+		return self
+	}
+	return defaultFunc()
+}
+
+func (self OptionV[T]) Inspect(inspectFunc func(T)) OptionV[T] {
+	if self.ok {
+		inspectFunc(self.value)
+	}
+	return self
+}
+
+func (self OptionV[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if self.ok {
+			yield(self.value)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package easyerror
+
+import (
+	"errors"
+	"testing"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestOptionV(t *testing.T) {
+	some := SomeV(123)
+	none := NoneV[int]()
+	myError := errors.New("MyError")
+	Assert(OptionV[int]{} == none)
+	Assert(some.IsSome())
+	Assert(!none.IsSome())
+	Assert(!some.IsNone())
+	Assert(none.IsNone())
+	Assert(some.Unwrap() == 123)
+	Assert(Recover[*sentinel.Panic](func() {none.Unwrap()}).None)
+	Assert(some.UnwrapOr(456) == 123)
+	Assert(none.UnwrapOr(456) == 456)
+	Assert(some.UnwrapOrElse(func() int {return 456}) == 123)
+	Assert(none.UnwrapOrElse(func() int {return 456}) == 456)
+	Assert(some.OkOr(myError).Unwrap() == 123)
+	Assert(none.OkOr(myError).UnwrapErr() == myError)
+	Assert(some.OkOrElse(func() error {return myError}).Unwrap() == 123)
+	Assert(none.OkOrElse(func() error {return myError}).UnwrapErr() == myError)
+	Assert(some.Filter(func(x int) bool {return true}) == some)
+	Assert(some.Filter(func(x int) bool {return false}) == none)
+	Assert(none.Filter(func(x int) bool {return true}) == none)
+	func1 := func(x int) int {return x * 2}
+	Assert(some.Map(func1) == SomeV(246))
+	Assert(none.Map(func1) == none)
+	Assert(some.MapOr(456, func1) == 246)
+	Assert(none.MapOr(456, func1) == 456)
+	Assert(some.MapOrElse(func() int {return 456}, func1) == 246)
+	Assert(none.MapOrElse(func() int {return 456}, func1) == 456)
+	func2 := func(x, y int) int {return x + y}
+	some2 := SomeV(456)
+	Assert(some.ZipWith(some2, func2) == SomeV(579))
+	Assert(some.ZipWith(none, func2) == none)
+	Assert(none.ZipWith(some2, func2) == none)
+	Assert(some.And(some2) == some2)
+	Assert(some.And(none) == none)
+	Assert(none.And(some2) == none)
+	Assert(some.Or(some2) == some)
+	Assert(none.Or(some2) == some2)
+	Assert(none.Or(none) == none)
+	Assert(some.Xor(some2) == none)
+	Assert(some.Xor(none) == some)
+	Assert(none.Xor(some2) == some2)
+	Assert(none.Xor(none) == none)
+	Assert(some.AndThen(func(int) OptionV[int] {return some2}) == some2)
+	Assert(none.AndThen(func(int) OptionV[int] {return some2}) == none)
+	Assert(some.OrElse(func() OptionV[int] {return some2}) == some)
+	Assert(none.OrElse(func() OptionV[int] {return some2}) == some2)
+	count := 0
+	for value := range some.Iter() {
+		count += value
+	}
+	for value := range none.Iter() {
+		count += value
+	}
+	Assert(count == 123)
+	Assert(some.Option().Unwrap() == 123)
+	Assert(none.Option().IsNone())
+	Assert(ToOptionV[int](&Some[int]{123}) == some)
+	Assert(ToOptionV[int](&None[int]{}) == none)
+	Assert(some.IsSomeAnd(func(x int) bool {return x == 123}) && !none.IsSomeAnd(func(int) bool {return true}))
+	Assert(!some.IsNoneOr(func(int) bool {return false}) && none.IsNoneOr(func(int) bool {return false}))
+	Assert(some.UnwrapOrDefault() == 123 && none.UnwrapOrDefault() == 0)
+	Assert(some.Expect("no panic") == 123)
+	Assert(Recover[*sentinel.Panic](func() {none.Expect("msg")}).Err.(error).Error() == "msg: " + ErrNone.Error())
+	inspected := 0
+	Assert(some.Inspect(func(x int) {inspected += x}) == some && none.Inspect(func(x int) {inspected += x}) == none)
+	Assert(inspected == 123)
+}
+
+func TestNoAllocations(t *testing.T) {
+	var option Option[int]
+	var res Result[int]
+	ok := Result[int](&Ok[int]{123})
+	some := Option[int](&Some[int]{123})
+	isOdd := func(x int) bool {return x%2 == 1}
+	double := func(x int) int {return x * 2}
+	// None{} is zero-sized, so &None[T]{} never allocates.
+	Assert(testing.AllocsPerRun(100, func() {option = &None[int]{}}) == 0)
+	Assert(testing.AllocsPerRun(100, func() {option = option.Map(double)}) == 0)
+	Assert(testing.AllocsPerRun(100, func() {_ = ok.Err()}) == 0)
+	Assert(testing.AllocsPerRun(100, func() {option = some.Filter(isOdd).Filter(func(int) bool {return false})}) == 0)
+	Assert(testing.AllocsPerRun(100, func() {
+		res = ToResultV(ok).Map(double).AndThen(func(x int) ResultV[int] {return OkV(x + 1)}).Result()
+	}) == 1) // Only Result() allocates.
+	Assert(testing.AllocsPerRun(100, func() {
+		_ = SomeV(123).Map(double).Filter(isOdd).OkOr(nil).UnwrapOr(0)
+	}) == 0)
+	Assert(option.IsNone() && res.Unwrap() == 247)
+}
@@ -0,0 +1,124 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// Error given by FirstOk() and FirstOkHedged() when called without providers.
+var ErrNoProviders = errors.New("result: no providers given")
+
+// Named source of a value, e.g. a cache or a database. See FirstOk().
+type Provider[T any] struct {
+	Name string
+	Call func(context.Context) Result[T]
+}
+
+// Error of the provider called Name. FirstOk() and FirstOkHedged() join one
+// of these per failed provider.
+type ProviderError struct {
+	Name string
+	Err error
+}
+
+func (self *ProviderError) Error() string {
+	return fmt.Sprintf("%s: %s", self.Name, self.Err)
+}
+
+func (self *ProviderError) Unwrap() error {
+	return self.Err
+}
+
+// Calls the providers one after the other until one of them returns Ok.
+// Any Ok{Value} -> the first Ok{Value}; the providers after it are not called
+// All Err{Error} -> Err{errors.Join(ProviderError{Name, Error}...)} in the order of the providers
+// ctx done before a provider is called -> the same, with ctx.Err() joined last
+// No providers -> Err{ErrNoProviders}
+// A panic in a provider counts as its Err{Error}, like with CatchAll().
+func FirstOk[T any](ctx context.Context, providers ...Provider[T]) Result[T] {
+	if len(providers) == 0 {
+		return &Err[T]{ErrNoProviders}
+	}
+	errs := make([]error, 0, len(providers))
+	for _, provider := range providers {
+		if ctx.Err() != nil {
+			return &Err[T]{errors.Join(append(errs, ctx.Err())...)}
+		}
+		res := callProvider[T](ctx, provider)
+		if res.IsOk() {
+			return res
+		}
+		errs = append(errs, &ProviderError{provider.Name, res.UnwrapErr()})
+	}
+	return &Err[T]{errors.Join(errs...)}
+}
+
+// Same as FirstOk() except that the next provider is also started when the
+// running ones haven't answered after the given delay. The first Ok{Value}
+// to complete wins and the context of the other providers is cancelled.
+// A failing provider starts the next one at once.
+// ctx done -> Err{...} as with FirstOk(), without waiting for the running providers
+func FirstOkHedged[T any](ctx context.Context, delay time.Duration, providers ...Provider[T]) Result[T] {
+	if len(providers) == 0 {
+		return &Err[T]{ErrNoProviders}
+	}
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	type outcome struct {
+		index int
+		res Result[T]
+	}
+	outcomes := make(chan outcome, len(providers))
+	started, finished := 0, 0
+	startNext := func() {
+		i := started
+		started++
+		go func() {
+			outcomes <- outcome{i, callProvider[T](ctx, providers[i])}
+		}()
+	}
+	errs := make([]error, len(providers))
+	joined := func(extra ...error) error {
+		var ret []error
+		for _, err := range errs {
+			if err != nil {
+				ret = append(ret, err)
+			}
+		}
+		return errors.Join(append(ret, extra...)...)
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	startNext()
+	for finished < started {
+		select {
+		case out := <-outcomes:
+			finished++
+			if out.res.IsOk() {
+				return out.res
+			}
+			errs[out.index] = &ProviderError{providers[out.index].Name, out.res.UnwrapErr()}
+			if started < len(providers) {
+				startNext()
+				timer.Reset(delay)
+			}
+		case <-timer.C:
+			if started < len(providers) {
+				startNext()
+				timer.Reset(delay)
+			}
+		case <-parent.Done():
+			return &Err[T]{joined(parent.Err())}
+		}
+	}
+	return &Err[T]{joined()}
+}
+
+func callProvider[T any](ctx context.Context, provider Provider[T]) (ret Result[T]) {
+	defer CatchAll[T](&ret)
+	return provider.Call(ctx)
+}
@@ -0,0 +1,84 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestFirstOk(t *testing.T) {
+	myError := errors.New("MyError")
+	ctx := context.Background()
+	var called []string
+	provider := func(name string, res Result[int]) Provider[int] {
+		return Provider[int]{name, func(context.Context) Result[int] {
+			called = append(called, name)
+			return res
+		}}
+	}
+
+	res := FirstOk[int](ctx, provider("cache", &Err[int]{myError}), provider("db", &Ok[int]{1}), provider("replica", &Ok[int]{2}))
+	Assert(res.Unwrap() == 1)
+	Assert(reflect.DeepEqual(called, []string{"cache", "db"}))
+
+	unwrapping := Provider[int]{"unwrap", func(context.Context) Result[int] {
+		return &Ok[int]{(&Err[int]{myError}).Unwrap()}
+	}}
+	err := FirstOk[int](ctx, provider("cache", &Err[int]{myError}), unwrapping).UnwrapErr()
+	Assert(err.Error() == "cache: MyError\nunwrap: MyError")
+	var providerErr *ProviderError
+	Assert(errors.As(err, &providerErr) && providerErr.Name == "cache")
+	Assert(errors.Is(err, myError))
+	Assert(FirstOk[int](ctx).UnwrapErr() == ErrNoProviders)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancelling := Provider[int]{"cancelling", func(context.Context) Result[int] {
+		cancel()
+		return &Err[int]{myError}
+	}}
+	called = nil
+	err = FirstOk[int](cancelled, cancelling, provider("db", &Ok[int]{1})).UnwrapErr()
+	Assert(errors.Is(err, context.Canceled) && called == nil)
+}
+
+func TestFirstOkHedged(t *testing.T) {
+	myError := errors.New("MyError")
+	ctx := context.Background()
+	slow := func(name string, res Result[int]) Provider[int] {
+		return Provider[int]{name, func(ctx context.Context) Result[int] {
+			select {
+			case <-ctx.Done():
+				return &Err[int]{ctx.Err()}
+			case <-time.After(time.Hour):
+				return res
+			}
+		}}
+	}
+	fast := func(name string, res Result[int]) Provider[int] {
+		return Provider[int]{name, func(context.Context) Result[int] {return res}}
+	}
+
+	// The slow provider is hedged after the delay and cancelled once the second one wins.
+	Assert(FirstOkHedged[int](ctx, time.Millisecond, slow("primary", &Ok[int]{1}), fast("replica", &Ok[int]{2})).Unwrap() == 2)
+	// A failure starts the next provider without waiting for the delay.
+	start := time.Now()
+	Assert(FirstOkHedged[int](ctx, time.Hour, fast("primary", &Err[int]{myError}), fast("replica", &Ok[int]{2})).Unwrap() == 2)
+	Assert(time.Since(start) < time.Minute)
+
+	err := FirstOkHedged[int](ctx, time.Millisecond, fast("primary", &Err[int]{myError}), fast("replica", &Err[int]{myError})).UnwrapErr()
+	Assert(err.Error() == "primary: MyError\nreplica: MyError")
+	Assert(FirstOkHedged[int](ctx, time.Millisecond).UnwrapErr() == ErrNoProviders)
+	panicking := Provider[int]{"panicking", func(context.Context) Result[int] {panic("raw panic")}}
+	Assert(FirstOkHedged[int](ctx, time.Hour, panicking, fast("replica", &Ok[int]{2})).Unwrap() == 2)
+	var panicErr *PanicError
+	Assert(errors.As(FirstOk[int](ctx, panicking).UnwrapErr(), &panicErr) && panicErr.Value == "raw panic")
+
+	timeout, cancel := context.WithTimeout(ctx, 10 * time.Millisecond)
+	defer cancel()
+	err = FirstOkHedged[int](timeout, time.Millisecond, slow("primary", &Ok[int]{1}), fast("replica", &Err[int]{myError})).UnwrapErr()
+	Assert(errors.Is(err, context.DeadlineExceeded) && errors.Is(err, myError))
+}
@@ -0,0 +1,172 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// Error given by Any() and Race() when called without futures.
+var ErrNoFutures = errors.New("result: no futures given")
+
+// Result of a function running in its own goroutine. Created by Go() or GoContext().
+type Future[T any] struct {
+	done chan struct{}
+	result Result[T]
+	cancel context.CancelFunc
+}
+
+// Runs the given function in a new goroutine and returns a Future for its Result.
+// The function runs under CatchAll(), so neither Unwrap() nor any other panic
+// in it crashes the process: they give Err{...} instead.
+func Go[T any](goFunc func() Result[T]) *Future[T] {
+	return GoContext[T](context.Background(), func(context.Context) Result[T] {
+		return goFunc()
+	})
+}
+
+// Same as Go() for functions taking a context. The context given to the
+// function is derived from ctx and is also cancelled by Future.Cancel().
+func GoContext[T any](ctx context.Context, goFunc func(context.Context) Result[T]) *Future[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	future := &Future[T]{make(chan struct{}), nil, cancel}
+	go func() {
+		defer close(future.done)
+		defer cancel()
+		future.result = runCaught[T](ctx, goFunc)
+	}()
+	return future
+}
+
+func runCaught[T any](ctx context.Context, goFunc func(context.Context) Result[T]) (ret Result[T]) {
+	defer CatchAll[T](&ret)
+	return goFunc(ctx)
+}
+
+// Closed once the Result is available.
+func (self *Future[T]) Done() <-chan struct{} {
+	return self.done
+}
+
+// Cancels the context given to the function. The Result is still whatever
+// the function returns.
+func (self *Future[T]) Cancel() {
+	self.cancel()
+}
+
+// Waits for the function to return and returns its Result.
+func (self *Future[T]) Await() Result[T] {
+	<-self.done
+	return self.result
+}
+
+// Same as Await() except that it gives up when ctx is done.
+// Returns Err{ctx.Err()} in that case. The function keeps running.
+func (self *Future[T]) AwaitContext(ctx context.Context) Result[T] {
+	select {
+	case <-self.done:
+		return self.result
+	case <-ctx.Done():
+		return &Err[T]{ctx.Err()}
+	}
+}
+
+// Same as Await() except that it gives up after the given duration.
+// Returns Err{context.DeadlineExceeded} in that case. The function keeps running.
+func (self *Future[T]) AwaitTimeout(timeout time.Duration) Result[T] {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-self.done:
+		return self.result
+	case <-timer.C:
+		return &Err[T]{context.DeadlineExceeded}
+	}
+}
+
+// Sends the index of every future once it is done, in completion order.
+// Close stop to end the goroutines still waiting on futures.
+func completions[T any](futures []*Future[T], stop <-chan struct{}) <-chan int {
+	indexes := make(chan int, len(futures))
+	for i, future := range futures {
+		go func() {
+			select {
+			case <-future.done:
+				indexes <- i
+			case <-stop:
+			}
+		}()
+	}
+	return indexes
+}
+
+func cancelAll[T any](futures []*Future[T]) {
+	for _, future := range futures {
+		future.Cancel()
+	}
+}
+
+// All Ok{Value} -> Ok{[Value1, Value2, ...]} in the order of the futures
+// Any Err{Error} -> the first Err{Error} to complete; the other futures are cancelled
+func All[T any](futures ...*Future[T]) Result[[]T] {
+	stop := make(chan struct{})
+	defer close(stop)
+	indexes := completions[T](futures, stop)
+	for range futures {
+		if res := futures[<-indexes].result; res.IsErr() {
+			cancelAll[T](futures)
+			return &Err[[]T]{res.UnwrapErr()}
+		}
+	}
+	values := make([]T, 0, len(futures))
+	for _, future := range futures {
+		values = append(values, future.result.Unwrap())
+	}
+	return &Ok[[]T]{values}
+}
+
+// Any Ok{Value} -> the first Ok{Value} to complete; the other futures are cancelled
+// All Err{Error} -> Err{errors.Join(every Error)} in the order of the futures
+// No futures -> Err{ErrNoFutures}
+func Any[T any](futures ...*Future[T]) Result[T] {
+	if len(futures) == 0 {
+		return &Err[T]{ErrNoFutures}
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	indexes := completions[T](futures, stop)
+	for range futures {
+		if res := futures[<-indexes].result; res.IsOk() {
+			cancelAll[T](futures)
+			return res
+		}
+	}
+	errs := make([]error, 0, len(futures))
+	for _, future := range futures {
+		errs = append(errs, future.result.UnwrapErr())
+	}
+	return &Err[T]{errors.Join(errs...)}
+}
+
+// Returns the Result of the first future to complete; the other futures are cancelled.
+// No futures -> Err{ErrNoFutures}
+func Race[T any](futures ...*Future[T]) Result[T] {
+	if len(futures) == 0 {
+		return &Err[T]{ErrNoFutures}
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	res := futures[<-completions[T](futures, stop)].result
+	cancelAll[T](futures)
+	return res
+}
+
+// Waits for every future and returns their Results in the order of the futures.
+func AllSettled[T any](futures ...*Future[T]) []Result[T] {
+	results := make([]Result[T], 0, len(futures))
+	for _, future := range futures {
+		results = append(results, future.Await())
+	}
+	return results
+}
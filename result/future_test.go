@@ -0,0 +1,122 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestFuture(t *testing.T) {
+	myError := errors.New("MyError")
+	Assert(Go[int](func() Result[int] {return &Ok[int]{123}}).Await().Unwrap() == 123)
+	Assert(Go[int](func() Result[int] {return &Err[int]{myError}}).Await().UnwrapErr() == myError)
+	// Unwrap() and foreign panics in the goroutine don't crash the process.
+	Assert(errors.Is(Go[int](func() Result[int] {
+		return &Ok[int]{(&Err[int]{myError}).Unwrap()}
+	}).Await().UnwrapErr(), myError))
+	var panicErr *PanicError
+	Assert(errors.As(Go[int](func() Result[int] {panic("raw panic")}).Await().UnwrapErr(), &panicErr))
+
+	release := make(chan struct{})
+	blocked := Go[int](func() Result[int] {
+		<-release
+		return &Ok[int]{123}
+	})
+	Assert(blocked.AwaitTimeout(time.Millisecond).UnwrapErr() == context.DeadlineExceeded)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	Assert(blocked.AwaitContext(ctx).UnwrapErr() == context.Canceled)
+	select {
+	case <-blocked.Done():
+		Assert(false)
+	default:
+	}
+	close(release)
+	<-blocked.Done()
+	Assert(blocked.AwaitTimeout(time.Hour).Unwrap() == 123)
+	Assert(blocked.AwaitContext(context.Background()).Unwrap() == 123)
+
+	cancelled := GoContext[int](context.Background(), func(ctx context.Context) Result[int] {
+		<-ctx.Done()
+		return &Err[int]{ctx.Err()}
+	})
+	cancelled.Cancel()
+	Assert(cancelled.Await().UnwrapErr() == context.Canceled)
+
+	parent, cancelParent := context.WithCancel(context.Background())
+	child := GoContext[int](parent, func(ctx context.Context) Result[int] {
+		<-ctx.Done()
+		return &Err[int]{ctx.Err()}
+	})
+	cancelParent()
+	Assert(child.Await().UnwrapErr() == context.Canceled)
+}
+
+// Returns a future which waits until its context is cancelled and then
+// returns Err{context.Canceled}.
+func goUntilCancelled() *Future[int] {
+	return GoContext[int](context.Background(), func(ctx context.Context) Result[int] {
+		<-ctx.Done()
+		return &Err[int]{ctx.Err()}
+	})
+}
+
+func goValue(value int) *Future[int] {
+	return Go[int](func() Result[int] {return &Ok[int]{value}})
+}
+
+func goError(err error) *Future[int] {
+	return Go[int](func() Result[int] {return &Err[int]{err}})
+}
+
+func TestCombinators(t *testing.T) {
+	myError := errors.New("MyError")
+	myError2 := errors.New("MyError2")
+
+	Assert(reflect.DeepEqual(All[int](goValue(1), goValue(2), goValue(3)).Unwrap(), []int{1, 2, 3}))
+	Assert(reflect.DeepEqual(All[int]().Unwrap(), []int{}))
+	slow := goUntilCancelled()
+	Assert(All[int](goValue(1), slow, goError(myError)).UnwrapErr() == myError)
+	Assert(slow.Await().UnwrapErr() == context.Canceled) // Cancelled by the failure.
+
+	slow = goUntilCancelled()
+	Assert(Any[int](goError(myError), slow, goValue(2)).Unwrap() == 2)
+	Assert(slow.Await().UnwrapErr() == context.Canceled)
+	joined := Any[int](goError(myError), goError(myError2)).UnwrapErr()
+	Assert(joined.Error() == "MyError\nMyError2")
+	Assert(Any[int]().UnwrapErr() == ErrNoFutures)
+
+	slow = goUntilCancelled()
+	Assert(Race[int](slow, goError(myError)).UnwrapErr() == myError)
+	Assert(slow.Await().UnwrapErr() == context.Canceled)
+	Assert(Race[int](goUntilCancelled(), goValue(1)).Unwrap() == 1)
+	Assert(Race[int]().UnwrapErr() == ErrNoFutures)
+
+	settled := AllSettled[int](goValue(1), goError(myError))
+	Assert(len(settled) == 2 && settled[0].Unwrap() == 1 && settled[1].UnwrapErr() == myError)
+	Assert(len(AllSettled[int]()) == 0)
+}
+
+func TestCombinatorsLeak(t *testing.T) {
+	release := make(chan struct{})
+	blocked := func() *Future[int] {
+		return Go[int](func() Result[int] {<-release; return &Ok[int]{1}})
+	}
+	// The 6 blocked futures keep running, the goroutines of the combinators
+	// waiting on them must not.
+	before := runtime.NumGoroutine() + 6
+	Assert(All[int](blocked(), blocked(), goError(errors.New("MyError"))).IsErr())
+	Assert(Any[int](blocked(), blocked(), goValue(1)).Unwrap() == 1)
+	Assert(Race[int](blocked(), blocked(), goValue(1)).Unwrap() == 1)
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	Assert(runtime.NumGoroutine() <= before)
+	close(release)
+}
@@ -0,0 +1,50 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// Wraps a Result so that it can be unmarshalled from JSON, e.g. as a struct
+// field, since the Result interface itself can't be unmarshalled into.
+// Ok{Value} is encoded as {"ok": Value} and Err{Error} as
+// {"err": {"message": ..., "code": ...}}. See easyerror.RegisterError() for codes.
+type JSON[T any] struct {
+	Result[T]
+}
+
+func (self JSON[T]) MarshalJSON() ([]byte, error) {
+	if self.Result == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(self.Result)
+}
+
+func (self *JSON[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		self.Result = nil
+		return nil
+	}
+	var wire map[string]json.RawMessage
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	if _, ok := wire["ok"]; ok {
+		res := &Ok[T]{}
+		if err := res.UnmarshalJSON(data); err != nil {
+			return err
+		}
+		self.Result = res
+		return nil
+	}
+	if _, ok := wire["err"]; ok {
+		res := &Err[T]{}
+		if err := res.UnmarshalJSON(data); err != nil {
+			return err
+		}
+		self.Result = res
+		return nil
+	}
+	return errors.New(`easyerror: Result can only be unmarshalled from {"ok": ...} or {"err": ...}`)
+}
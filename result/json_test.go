@@ -0,0 +1,41 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestJSON(t *testing.T) {
+	sentinel := errors.New("result: not found")
+	RegisterError("result.not_found", sentinel)
+	type message struct {
+		Outcome JSON[string] `json:"outcome"`
+	}
+
+	data, err := json.Marshal(message{JSON[string]{&Ok[string]{"x"}}})
+	Assert(err == nil && string(data) == `{"outcome":{"ok":"x"}}`)
+	var m message
+	Assert(json.Unmarshal(data, &m) == nil)
+	Assert(m.Outcome.Unwrap() == "x")
+
+	data, err = json.Marshal(message{JSON[string]{&Err[string]{sentinel}}})
+	Assert(err == nil)
+	Assert(json.Unmarshal(data, &m) == nil)
+	Assert(m.Outcome.IsErr() && m.Outcome.UnwrapErr() == sentinel)
+
+	data, err = json.Marshal(message{})
+	Assert(err == nil && string(data) == `{"outcome":null}`)
+	Assert(json.Unmarshal(data, &m) == nil && m.Outcome.Result == nil)
+	Assert(json.Unmarshal([]byte(`{"outcome":{}}`), &m) != nil)
+	Assert(json.Unmarshal([]byte(`{"outcome":[]}`), &m) != nil)
+	Assert(json.Unmarshal([]byte(`{"outcome":{"ok":1}}`), &m) != nil)
+	Assert(json.Unmarshal([]byte(`{"outcome":{"err":1}}`), &m) != nil)
+
+	data, err = json.Marshal(JSON[[]int]{&Ok[[]int]{nil}})
+	Assert(err == nil && string(data) == `{"ok":null}`)
+	var slice JSON[[]int]
+	Assert(json.Unmarshal(data, &slice) == nil && slice.IsOk() && slice.Unwrap() == nil)
+}
@@ -0,0 +1,85 @@
+package result
+
+import (
+	"sync"
+	"sync/atomic"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// What Lazy.Get() does with an Err{Error} returned by the function.
+type LazyPolicy int
+
+const (
+	// The Err{Error} is kept and returned by every later Get(), like an Ok{Value}.
+	CacheErrors LazyPolicy = iota
+	// The Err{Error} is only returned to the callers which were already
+	// waiting for it. The next Get() calls the function again.
+	RetryErrors
+)
+
+// Result computed by a function on first use. Created by NewLazy(). It is safe
+// for concurrent use: the function runs once at a time and the callers of
+// Get() meanwhile wait for its Result.
+type Lazy[T any] struct {
+	mutex sync.Mutex
+	lazyFunc func() Result[T]
+	policy LazyPolicy
+	done atomic.Bool
+	res Result[T]
+	call *lazyCall[T] // Running call of the function, if any.
+}
+
+// Call of the function shared by the callers of Get() arriving while it runs.
+type lazyCall[T any] struct {
+	done chan struct{}
+	res Result[T] // nil if the function panicked.
+}
+
+func NewLazy[T any](lazyFunc func() Result[T], policy LazyPolicy) *Lazy[T] {
+	return &Lazy[T]{lazyFunc: lazyFunc, policy: policy}
+}
+
+// Returns the cached Result, calling the function first if there is none.
+// A panic in the function is re-raised and the next Get() calls it again.
+func (self *Lazy[T]) Get() Result[T] {
+	if self.done.Load() {
+		return self.res
+	}
+	self.mutex.Lock()
+	if self.done.Load() {
+		self.mutex.Unlock()
+		return self.res
+	}
+	if call := self.call; call != nil {
+		self.mutex.Unlock()
+		<-call.done
+		if call.res != nil {
+			return call.res
+		}
+		return self.Get() // The function panicked in its caller; try again.
+	}
+	call := &lazyCall[T]{done: make(chan struct{})}
+	self.call = call
+	self.mutex.Unlock()
+	defer func() {
+		self.mutex.Lock()
+		self.call = nil
+		self.mutex.Unlock()
+		close(call.done)
+	}()
+	res := self.lazyFunc()
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	call.res = res
+	if res.IsOk() || self.policy == CacheErrors {
+		self.res = res
+		self.done.Store(true)
+	}
+	return res
+}
+
+// Returns a function calling onceFunc on first use and returning its Result,
+// Ok or Err, from then on. Same as NewLazy(onceFunc, CacheErrors).Get.
+func OnceValue[T any](onceFunc func() Result[T]) func() Result[T] {
+	return NewLazy[T](onceFunc, CacheErrors).Get
+}
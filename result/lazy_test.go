@@ -0,0 +1,101 @@
+package result
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestLazy(t *testing.T) {
+	myError := errors.New("MyError")
+	calls := 0
+	failingOnce := func() Result[int] {
+		calls++
+		if calls == 1 {
+			return &Err[int]{myError}
+		}
+		return &Ok[int]{calls}
+	}
+
+	cached := NewLazy[int](failingOnce, CacheErrors)
+	Assert(cached.Get().UnwrapErr() == myError && cached.Get().UnwrapErr() == myError && calls == 1)
+
+	calls = 0
+	retried := NewLazy[int](failingOnce, RetryErrors)
+	Assert(retried.Get().UnwrapErr() == myError)
+	Assert(retried.Get().Unwrap() == 2 && retried.Get().Unwrap() == 2 && calls == 2)
+
+	calls = 0
+	once := OnceValue[int](failingOnce)
+	Assert(once().UnwrapErr() == myError && once().UnwrapErr() == myError && calls == 1)
+
+	panicking := NewLazy[int](func() Result[int] {
+		calls++
+		if calls == 2 {
+			panic("boom")
+		}
+		return &Ok[int]{calls}
+	}, CacheErrors)
+	Assert(Recover[string](func() {panicking.Get()}) == "boom")
+	Assert(panicking.Get().Unwrap() == 3)
+
+	var mutex sync.Mutex
+	calls = 0
+	concurrent := NewLazy[int](func() Result[int] {
+		mutex.Lock()
+		defer mutex.Unlock()
+		calls++
+		return &Ok[int]{123}
+	}, CacheErrors)
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Assert(concurrent.Get().Unwrap() == 123)
+		}()
+	}
+	wg.Wait()
+	Assert(calls == 1)
+}
+
+func TestLazyRetryErrorsConcurrently(t *testing.T) {
+	myError := errors.New("MyError")
+	var mutex sync.Mutex
+	calls := 0
+	release := make(chan struct{})
+	lazy := NewLazy[int](func() Result[int] {
+		mutex.Lock()
+		calls++
+		first := calls == 1
+		mutex.Unlock()
+		if first {
+			<-release
+			return &Err[int]{myError}
+		}
+		return &Ok[int]{123}
+	}, RetryErrors)
+
+	// The callers waiting for the first call all get its Err{Error}.
+	var wg sync.WaitGroup
+	results := make([]Result[int], 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = lazy.Get()
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	for _, res := range results {
+		Assert(res.UnwrapErr() == myError)
+	}
+	Assert(calls == 1)
+	// A later caller tries again.
+	Assert(lazy.Get().Unwrap() == 123 && calls == 2)
+}
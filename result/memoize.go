@@ -0,0 +1,151 @@
+package result
+
+import (
+	"container/list"
+	"sync"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+type MemoizeOptions struct {
+	// How long an Ok{Value} stays cached. 0 means forever.
+	OkTTL time.Duration
+	// How long an Err{Error} stays cached. 0 means errors aren't cached.
+	ErrTTL time.Duration
+	// Number of keys cached at most; the least recently used one is evicted
+	// first. 0 means no limit.
+	MaxEntries int
+	// Merges concurrent calls for the same key into a single call of the
+	// function. Every caller gets the same Result.
+	Singleflight bool
+	// Time source of the TTLs. nil means time.Now.
+	Now func() time.Time
+}
+
+type memoEntry[K comparable, V any] struct {
+	key K
+	res Result[V]
+	expires time.Time // The zero value means never.
+}
+
+// Call of the function shared by the callers of the same key.
+type memoCall[V any] struct {
+	done chan struct{}
+	res Result[V] // nil if the function panicked.
+}
+
+type memo[K comparable, V any] struct {
+	mutex sync.Mutex
+	memoFunc func(K) Result[V]
+	options MemoizeOptions
+	entries map[K]*list.Element
+	recent *list.List // Of *memoEntry, most recently used first.
+	calls map[K]*memoCall[V]
+}
+
+// Returns a function caching the Results of memoFunc per key. It is safe for
+// concurrent use. See MemoizeOptions for how long Results are kept.
+// Expired Results are only dropped when their key is read again or when
+// MaxEntries evicts them, so set MaxEntries to bound memory use when many
+// keys are read only once.
+func Memoize[K comparable, V any](memoFunc func(K) Result[V], options MemoizeOptions) func(K) Result[V] {
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	memo := &memo[K, V]{
+		memoFunc: memoFunc,
+		options: options,
+		entries: make(map[K]*list.Element),
+		recent: list.New(),
+		calls: make(map[K]*memoCall[V]),
+	}
+	return memo.get
+}
+
+func (self *memo[K, V]) get(key K) Result[V] {
+	for {
+		self.mutex.Lock()
+		if res := self.cached(key); res != nil {
+			self.mutex.Unlock()
+			return res
+		}
+		if !self.options.Singleflight {
+			self.mutex.Unlock()
+			return self.call(key, nil)
+		}
+		if call, ok := self.calls[key]; ok {
+			self.mutex.Unlock()
+			<-call.done
+			if call.res != nil {
+				return call.res
+			}
+			continue // The function panicked in its caller; try again.
+		}
+		call := &memoCall[V]{done: make(chan struct{})}
+		self.calls[key] = call
+		self.mutex.Unlock()
+		return self.call(key, call)
+	}
+}
+
+// Returns the cached Result of the key, or nil. Must be called with the lock held.
+func (self *memo[K, V]) cached(key K) Result[V] {
+	element, ok := self.entries[key]
+	if !ok {
+		return nil
+	}
+	entry := element.Value.(*memoEntry[K, V])
+	if !entry.expires.IsZero() && !self.options.Now().Before(entry.expires) {
+		self.recent.Remove(element)
+		delete(self.entries, key)
+		return nil
+	}
+	self.recent.MoveToFront(element)
+	return entry.res
+}
+
+// Calls the function and stores its Result. call is nil outside singleflight mode.
+func (self *memo[K, V]) call(key K, call *memoCall[V]) Result[V] {
+	if call != nil {
+		defer func() {
+			self.mutex.Lock()
+			delete(self.calls, key)
+			self.mutex.Unlock()
+			close(call.done)
+		}()
+	}
+	res := self.memoFunc(key)
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	if call != nil {
+		call.res = res
+	}
+	self.store(key, res)
+	return res
+}
+
+// Must be called with the lock held.
+func (self *memo[K, V]) store(key K, res Result[V]) {
+	ttl := self.options.OkTTL
+	if res.IsErr() {
+		if self.options.ErrTTL <= 0 {
+			return
+		}
+		ttl = self.options.ErrTTL
+	}
+	entry := &memoEntry[K, V]{key, res, time.Time{}}
+	if ttl > 0 {
+		entry.expires = self.options.Now().Add(ttl)
+	}
+	if element, ok := self.entries[key]; ok {
+		element.Value = entry
+		self.recent.MoveToFront(element)
+		return
+	}
+	self.entries[key] = self.recent.PushFront(entry)
+	if self.options.MaxEntries > 0 && self.recent.Len() > self.options.MaxEntries {
+		oldest := self.recent.Back()
+		self.recent.Remove(oldest)
+		delete(self.entries, oldest.Value.(*memoEntry[K, V]).key)
+	}
+}
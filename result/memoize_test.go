@@ -0,0 +1,88 @@
+package result
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+	"github.com/Sh1kharGupta/easyerror/internal/clock"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestMemoize(t *testing.T) {
+	myError := errors.New("MyError")
+	fake := &clock.Fake{}
+	calls := map[int]int{}
+	double := func(x int) Result[int] {
+		calls[x]++
+		if x < 0 {
+			return &Err[int]{myError}
+		}
+		return &Ok[int]{x * 2}
+	}
+
+	memoized := Memoize[int, int](double, MemoizeOptions{Now: fake.Now})
+	Assert(memoized(1).Unwrap() == 2 && memoized(1).Unwrap() == 2 && calls[1] == 1)
+	Assert(memoized(-1).UnwrapErr() == myError && memoized(-1).UnwrapErr() == myError && calls[-1] == 2)
+
+	calls = map[int]int{}
+	memoized = Memoize[int, int](double, MemoizeOptions{OkTTL: time.Minute, ErrTTL: time.Second, Now: fake.Now})
+	memoized(1); memoized(-1)
+	fake.Advance(time.Second)
+	memoized(1); memoized(-1)
+	Assert(calls[1] == 1 && calls[-1] == 2)
+	fake.Advance(time.Minute)
+	memoized(1)
+	Assert(calls[1] == 2)
+
+	calls = map[int]int{}
+	memoized = Memoize[int, int](double, MemoizeOptions{MaxEntries: 2, Now: fake.Now})
+	memoized(1); memoized(2); memoized(1); memoized(3)
+	memoized(1); memoized(3)
+	Assert(calls[1] == 1 && calls[3] == 1)
+	memoized(2)
+	Assert(calls[2] == 2)
+}
+
+func TestMemoizeSingleflight(t *testing.T) {
+	var mutex sync.Mutex
+	calls := 0
+	release := make(chan struct{})
+	memoized := Memoize[int, int](func(x int) Result[int] {
+		mutex.Lock()
+		calls++
+		mutex.Unlock()
+		<-release
+		return &Ok[int]{x * 2}
+	}, MemoizeOptions{Singleflight: true})
+
+	var wg sync.WaitGroup
+	results := make([]Result[int], 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = memoized(1)
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	Assert(calls == 1)
+	for _, res := range results {
+		Assert(res == results[0])
+	}
+
+	// A panic isn't shared: the function is called again.
+	panicking := true
+	memoized = Memoize[int, int](func(x int) Result[int] {
+		if panicking {
+			panicking = false
+			panic("boom")
+		}
+		return &Ok[int]{x * 2}
+	}, MemoizeOptions{Singleflight: true})
+	Assert(Recover[string](func() {memoized(1)}) == "boom")
+	Assert(memoized(1).Unwrap() == 2)
+}
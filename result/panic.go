@@ -0,0 +1,100 @@
+package result
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/debug"
+	. "github.com/Sh1kharGupta/easyerror"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+)
+
+// Classification of a panic caught by CatchAll().
+type PanicKind int
+
+const (
+	// runtime.Error, e.g. a nil dereference or an index out of range.
+	RuntimePanic PanicKind = iota
+	// Any other error value.
+	ErrorPanic
+	// Any other value.
+	ValuePanic
+)
+
+func (self PanicKind) String() string {
+	switch self {
+	case RuntimePanic:
+		return "runtime error"
+	case ErrorPanic:
+		return "error"
+	}
+	return "value"
+}
+
+// Error given by CatchAll() to a panic which was not raised by easyerror.
+type PanicError struct {
+	// Value passed to panic().
+	Value any
+	Kind PanicKind
+	// Stack of the panicking goroutine, as returned by debug.Stack().
+	Stack []byte
+}
+
+func newPanicError(value any) *PanicError {
+	kind := ValuePanic
+	switch value.(type) {
+	case runtime.Error:
+		kind = RuntimePanic
+	case error:
+		kind = ErrorPanic
+	}
+	return &PanicError{value, kind, debug.Stack()}
+}
+
+func (self *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", self.Value)
+}
+
+// Returns the panic value if it is an error, else nil.
+func (self *PanicError) Unwrap() error {
+	err, _ := self.Value.(error)
+	return err
+}
+
+// %s, %v and any other verb -> error message
+// %q -> quoted error message
+// %+v -> error message followed by the goroutine stack
+func (self *PanicError) Format(state fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if state.Flag('+') {
+			fmt.Fprintf(state, "%s\n%s", self.Error(), self.Stack)
+			return
+		}
+		io.WriteString(state, self.Error())
+	case 'q':
+		fmt.Fprintf(state, "%q", self.Error())
+	default:
+		io.WriteString(state, self.Error())
+	}
+}
+
+// Same as Catch() except that any other panic is caught as well and gives
+// Err{*PanicError} holding the panic value, its classification and the stack.
+// For Unwrap() or Expect() on ErrE{Error} whose Error is not an error, the
+// value is Error itself.
+// Meant for the boundary of handler code which must not crash.
+func CatchAll[T any](ret *Result[T]) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if e, ok := sentinelError(r); ok {
+		*ret = &Err[T]{e}
+		return
+	}
+	if p, ok := r.(*sentinel.Panic); ok { // ErrE{Error} whose Error is not an error.
+		r = p.Err
+	}
+	*ret = &Err[T]{newPanicError(r)}
+}
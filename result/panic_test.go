@@ -0,0 +1,60 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestCatchAll(t *testing.T) {
+	myError := errors.New("MyError")
+	func1 := func(condition int) (ret Result[int]) {
+		defer CatchAll[int](&ret)
+		switch condition {
+		case 0:
+			var m map[string]int
+			m["x"] = 1
+		case 1:
+			panic(myError)
+		case 2:
+			panic("raw panic")
+		case 3:
+			(&Err[int]{myError}).Unwrap()
+		case 4:
+			(&ErrE[int, string]{"MyError"}).Unwrap()
+		}
+		return &Ok[int]{123}
+	}
+	Assert(func1(5).Unwrap() == 123)
+
+	var panicErr *PanicError
+	var runtimeErr runtime.Error
+	err := func1(0).UnwrapErr()
+	Assert(errors.As(err, &panicErr) && panicErr.Kind == RuntimePanic)
+	Assert(errors.As(err, &runtimeErr))
+	Assert(strings.Contains(string(panicErr.Stack), "TestCatchAll"))
+	Assert(strings.HasPrefix(fmt.Sprintf("%+v", err), err.Error() + "\ngoroutine "))
+	Assert(fmt.Sprintf("%d", err) == err.Error() && fmt.Sprintf("%x", err) == err.Error())
+
+	err = func1(1).UnwrapErr()
+	Assert(errors.As(err, &panicErr) && panicErr.Kind == ErrorPanic)
+	Assert(errors.Is(err, myError) && panicErr.Value == myError)
+	Assert(fmt.Sprintf("%v", err) == "panic: MyError")
+	Assert(fmt.Sprintf("%s", err) == "panic: MyError")
+	Assert(fmt.Sprintf("%q", err) == `"panic: MyError"`)
+
+	err = func1(2).UnwrapErr()
+	Assert(errors.As(err, &panicErr) && panicErr.Kind == ValuePanic)
+	Assert(panicErr.Value == "raw panic" && errors.Unwrap(err) == nil)
+
+	err = func1(3).UnwrapErr() // easyerror's own panics are handled like Catch().
+	Assert(!errors.As(err, &panicErr) && errors.Is(err, myError))
+
+	err = func1(4).UnwrapErr()
+	Assert(errors.As(err, &panicErr) && panicErr.Kind == ValuePanic && panicErr.Value == "MyError")
+	Assert(RuntimePanic.String() == "runtime error" && ErrorPanic.String() == "error" && ValuePanic.String() == "value")
+}
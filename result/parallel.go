@@ -0,0 +1,87 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"sync"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// Same as Traverse() except that the function runs on up to the given number
+// of goroutines at once. The values keep the order of the inputs.
+// The first Err{Error} cancels the context given to the remaining calls and is
+// returned. Panics in the function are caught the way CatchAll() does, so
+// that a worker goroutine never crashes the process.
+// Err{ctx.Err()} is returned if ctx is done before every input is handled.
+func ParallelTraverse[T1, T2 any](ctx context.Context, inputs []T1, workers int, transformFunc func(context.Context, T1) Result[T2]) Result[[]T2] {
+	return parallelTraverse[T1, T2](ctx, inputs, workers, transformFunc, false)
+}
+
+// Same as ParallelTraverse() except that an Err{Error} does not stop the
+// other calls. Returns Err{errors.Join(every Error)} in the order of the inputs.
+func ParallelTraverseAll[T1, T2 any](ctx context.Context, inputs []T1, workers int, transformFunc func(context.Context, T1) Result[T2]) Result[[]T2] {
+	return parallelTraverse[T1, T2](ctx, inputs, workers, transformFunc, true)
+}
+
+func parallelTraverse[T1, T2 any](parent context.Context, inputs []T1, workers int, transformFunc func(context.Context, T1) Result[T2], collectAll bool) Result[[]T2] {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	outputs := make([]Result[T2], len(inputs))
+	var firstErr error
+	var firstErrOnce sync.Once
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < max(workers, 1) && worker < len(inputs); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				outputs[i] = callCaught[T1, T2](ctx, inputs[i], transformFunc)
+				if outputs[i].IsErr() && !collectAll {
+					firstErrOnce.Do(func() {firstErr = outputs[i].UnwrapErr()})
+					cancel()
+				}
+			}
+		}()
+	}
+feed:
+	for i := range inputs {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return &Err[[]T2]{firstErr}
+	}
+	values := make([]T2, 0, len(inputs))
+	var errs []error
+	for _, output := range outputs {
+		switch {
+		case output == nil && len(errs) == 0: // Never handled because parent is done.
+			return &Err[[]T2]{parent.Err()}
+		case output == nil:
+			return &Err[[]T2]{errors.Join(append(errs, parent.Err())...)}
+		case output.IsErr():
+			errs = append(errs, output.UnwrapErr())
+		default:
+			values = append(values, output.Unwrap())
+		}
+	}
+	if len(errs) > 0 {
+		return &Err[[]T2]{errors.Join(errs...)}
+	}
+	return &Ok[[]T2]{values}
+}
+
+func callCaught[T1, T2 any](ctx context.Context, input T1, transformFunc func(context.Context, T1) Result[T2]) (ret Result[T2]) {
+	defer CatchAll[T2](&ret)
+	return transformFunc(ctx, input)
+}
@@ -0,0 +1,87 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestParallelTraverse(t *testing.T) {
+	myError := errors.New("MyError")
+	inputs := make([]int, 50)
+	for i := range inputs {
+		inputs[i] = i
+	}
+	expected := make([]int, 50)
+	for i := range expected {
+		expected[i] = i * 2
+	}
+
+	var running, maxRunning int32
+	double := func(ctx context.Context, x int) Result[int] {
+		now := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			old := atomic.LoadInt32(&maxRunning)
+			if now <= old || atomic.CompareAndSwapInt32(&maxRunning, old, now) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return &Ok[int]{x * 2}
+	}
+	Assert(reflect.DeepEqual(ParallelTraverse[int, int](context.Background(), inputs, 4, double).Unwrap(), expected))
+	Assert(maxRunning >= 1 && maxRunning <= 4)
+	Assert(reflect.DeepEqual(ParallelTraverseAll[int, int](context.Background(), inputs, 0, double).Unwrap(), expected))
+	Assert(reflect.DeepEqual(ParallelTraverse[int, int](context.Background(), nil, 4, double).Unwrap(), []int{}))
+
+	var calls int32
+	failAt := func(bad int) func(context.Context, int) Result[int] {
+		return func(ctx context.Context, x int) Result[int] {
+			atomic.AddInt32(&calls, 1)
+			if x == bad || x == bad+1 {
+				return &Err[int]{myError}
+			}
+			select {
+			case <-ctx.Done(): // Cancelled by the failure.
+				return &Err[int]{ctx.Err()}
+			case <-time.After(time.Millisecond):
+			}
+			return &Ok[int]{x}
+		}
+	}
+	Assert(ParallelTraverse[int, int](context.Background(), inputs, 2, failAt(3)).UnwrapErr() == myError)
+	Assert(calls < 50) // The remaining inputs were not handled.
+
+	calls = 0
+	joined := ParallelTraverseAll[int, int](context.Background(), inputs, 2, failAt(3)).UnwrapErr()
+	Assert(calls == 50)
+	Assert(joined.Error() == "MyError\nMyError")
+
+	unwrapping := func(ctx context.Context, x int) Result[int] {
+		if x == 10 {
+			(&Err[int]{myError}).Unwrap()
+		}
+		return &Ok[int]{x}
+	}
+	Assert(errors.Is(ParallelTraverse[int, int](context.Background(), inputs, 3, unwrapping).UnwrapErr(), myError))
+	panicking := func(ctx context.Context, x int) Result[int] {
+		if x == 10 {
+			panic("raw panic")
+		}
+		return &Ok[int]{x}
+	}
+	var panicErr *PanicError
+	Assert(errors.As(ParallelTraverse[int, int](context.Background(), inputs, 3, panicking).UnwrapErr(), &panicErr))
+	Assert(panicErr.Value == "raw panic")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	Assert(ParallelTraverse[int, int](ctx, inputs, 3, double).UnwrapErr() == context.Canceled)
+	Assert(errors.Is(ParallelTraverseAll[int, int](ctx, inputs, 3, double).UnwrapErr(), context.Canceled))
+}
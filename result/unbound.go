@@ -1,34 +1,88 @@
 package result
 
 import (
-	"reflect"
+	"errors"
+	"fmt"
 	. "github.com/Sh1kharGupta/easyerror"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
 )
 
-// Can catch panics by Unwrap() on Err{Error}. Please see README for usage.
+// Error given by Catch() and CatchErr() for a panic by Unwrap() or Expect()
+// on Err{nil}, so that the caught panic never looks like a success.
+var ErrNilError = errors.New("result: Unwrap() called on Err with a nil error")
+
+// Error of the input at position Index. Returned by PartitionIndexed().
+type IndexedError struct {
+	Index int
+	Err error
+}
+
+func (self *IndexedError) Error() string {
+	return fmt.Sprintf("index %d: %s", self.Index, self.Err)
+}
+
+func (self *IndexedError) Unwrap() error {
+	return self.Err
+}
+
+// Can catch panics by Unwrap() or Expect() on Err{Error}. Please see README for usage.
+// The caught error is wrapped in an easyerror.SiteError recording where the call was.
+// Panics by Unwrap() on None{} are caught as well and give Err{easyerror.ErrNone}.
+// Any other panic is re-raised with its original value.
 func Catch[T any](ret *Result[T]) {
 	r := recover()
 	if r == nil {
 		return
 	}
-	vp := reflect.ValueOf(r)
-	if vp.Kind() != reflect.Pointer {
-		panic(r)
-	}
-	v := vp.Elem()
-	// TODO: improve these conditions to be more stringent.
-	if v.Kind() != reflect.Struct || v.NumField() != 1 || v.Type().Field(0).Name != "Error" {
-		panic(r)
+	*ret = &Err[T]{caughtError(r)}
+}
+
+// Same as Catch() for functions returning (T, error) which use Unwrap() internally.
+// Stores the caught error in the given named error return.
+func CatchErr(ret *error) {
+	r := recover()
+	if r == nil {
+		return
 	}
-	f := v.Field(0)
-	if f.Kind() != reflect.Interface {
+	*ret = caughtError(r)
+}
+
+// Runs the given function under Catch().
+// Returns Ok{return value} or Err{error of the caught panic}.
+func Try[T any](tryFunc func() T) (ret Result[T]) {
+	defer Catch[T](&ret)
+	return &Ok[T]{tryFunc()}
+}
+
+// Returns the error of a recovered panic by Unwrap() or Expect(), wrapped in
+// an easyerror.SiteError holding the location of the call.
+// Re-raises any other panic.
+func caughtError(r any) error {
+	e, ok := sentinelError(r)
+	if !ok {
 		panic(r)
 	}
-	e, ok := f.Interface().(error)
+	return e
+}
+
+// Same as caughtError() except that it reports whether r was caught instead
+// of re-raising it.
+func sentinelError(r any) (error, bool) {
+	p, ok := r.(*sentinel.Panic)
 	if !ok {
-		panic(r)
+		return nil, false
 	}
-	*ret = &Err[T]{e}
+	e, ok := p.Err.(error)
+	if !ok && p.Err != nil { // ErrE{Error} whose Error is not an error.
+		return nil, false
+	}
+	if e == nil {
+		e = ErrNilError
+	}
+	if p.Msg != "" { // ErrE.Expect(), which leaves the message to the catcher.
+		e = fmt.Errorf("%s: %w", p.Msg, e)
+	}
+	return &SiteError{e, p.PC}, true
 }
 
 // Ok{Some{Value}} -> Some{Ok{Value}}
@@ -89,6 +143,36 @@ func AndThen[T1, T2 any](first Result[T1], transformFunc func(T1) Result[T2]) Re
 	return transformFunc(first.Unwrap())
 }
 
+// Ok{Ok{Value}} -> Ok{Value}
+// Ok{Err{Error}} -> Err{Error}
+// Err{Error} -> Err{Error}
+func Flatten[T any](input Result[Result[T]]) Result[T] {
+	if input.IsErr() {
+		return &Err[T]{input.UnwrapErr()}
+	}
+	return input.Unwrap()
+}
+
+// Ok{&Value} -> Ok{Value}
+// Err{Error} -> Err{Error}
+// > panics like any nil dereference on Ok{nil}.
+func Copied[T any](input Result[*T]) Result[T] {
+	if input.IsErr() {
+		return &Err[T]{input.UnwrapErr()}
+	}
+	return &Ok[T]{*input.Unwrap()}
+}
+
+// Similar to the bound MapErr() method except that the error can change to any type.
+// Ok{Value} -> OkE{Value}
+// Err{Error} -> ErrE{func(Error)}
+func MapErrE[T, E any](input Result[T], transformFunc func(error) E) ResultE[T, E] {
+	if input.IsErr() {
+		return &ErrE[T, E]{transformFunc(input.UnwrapErr())}
+	}
+	return &OkE[T, E]{input.Unwrap()}
+}
+
 // Convert a function's return (value T, err error) to:-
 // Ok{value} if err is nil
 // Err{err} if err is not nil
@@ -96,5 +180,121 @@ func Convert[T any](value T, err error) Result[T] {
 	if err == nil {
 		return &Ok[T]{value}
 	}
-	return &Err[T]{err}
+	return &Err[T]{WithStack(err)}
+}
+
+// Returns Err{err}. Unlike a plain &Err[T]{err} literal, the error records the
+// caller's stack when easyerror.CaptureStack is true.
+func NewErr[T any](err error) Result[T] {
+	return &Err[T]{WithStack(err)}
+}
+
+// [Ok{Value1}, Ok{Value2}, ...] -> Ok{[Value1, Value2, ...]}
+// Any Err{Error} -> the first Err{Error}
+func Sequence[T any](inputs []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(inputs))
+	for _, input := range inputs {
+		if input.IsErr() {
+			return &Err[[]T]{input.UnwrapErr()}
+		}
+		values = append(values, input.Unwrap())
+	}
+	return &Ok[[]T]{values}
+}
+
+// Same as Sequence() on the results of the given function applied to every input.
+// The function is not called on the inputs after the first Err{Error}.
+func Traverse[T1, T2 any](inputs []T1, transformFunc func(T1) Result[T2]) Result[[]T2] {
+	values := make([]T2, 0, len(inputs))
+	for _, input := range inputs {
+		output := transformFunc(input)
+		if output.IsErr() {
+			return &Err[[]T2]{output.UnwrapErr()}
+		}
+		values = append(values, output.Unwrap())
+	}
+	return &Ok[[]T2]{values}
+}
+
+// [Ok{Value1}, Ok{Value2}, ...] -> Ok{[Value1, Value2, ...]}
+// Any Err{Error} -> Err{errors.Join(every Error)}
+func SequenceAll[T any](inputs []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(inputs))
+	var errs []error
+	for _, input := range inputs {
+		if input.IsErr() {
+			errs = append(errs, input.UnwrapErr())
+		} else {
+			values = append(values, input.Unwrap())
+		}
+	}
+	if len(errs) > 0 {
+		return &Err[[]T]{errors.Join(errs...)}
+	}
+	return &Ok[[]T]{values}
+}
+
+// Same as SequenceAll() on the results of the given function applied to every input.
+func TraverseAll[T1, T2 any](inputs []T1, transformFunc func(T1) Result[T2]) Result[[]T2] {
+	outputs := make([]Result[T2], 0, len(inputs))
+	for _, input := range inputs {
+		outputs = append(outputs, transformFunc(input))
+	}
+	return SequenceAll[T2](outputs)
+}
+
+// {Key1: Ok{Value1}, Key2: Ok{Value2}, ...} -> Ok{{Key1: Value1, Key2: Value2, ...}}
+// Any Err{Error} -> one of the Err{Error} (map iteration order is random)
+func SequenceMap[K comparable, T any](inputs map[K]Result[T]) Result[map[K]T] {
+	values := make(map[K]T, len(inputs))
+	for key, input := range inputs {
+		if input.IsErr() {
+			return &Err[map[K]T]{input.UnwrapErr()}
+		}
+		values[key] = input.Unwrap()
+	}
+	return &Ok[map[K]T]{values}
+}
+
+// Same as SequenceMap() on the results of the given function applied to every entry.
+// The function is not called on the remaining entries after an Err{Error}.
+func TraverseMap[K comparable, T1, T2 any](inputs map[K]T1, transformFunc func(K, T1) Result[T2]) Result[map[K]T2] {
+	values := make(map[K]T2, len(inputs))
+	for key, input := range inputs {
+		output := transformFunc(key, input)
+		if output.IsErr() {
+			return &Err[map[K]T2]{output.UnwrapErr()}
+		}
+		values[key] = output.Unwrap()
+	}
+	return &Ok[map[K]T2]{values}
+}
+
+// Splits the inputs into the values of Ok{Value} and the errors of Err{Error},
+// both in input order.
+func Partition[T any](inputs []Result[T]) ([]T, []error) {
+	var values []T
+	var errs []error
+	for _, input := range inputs {
+		if input.IsErr() {
+			errs = append(errs, input.UnwrapErr())
+		} else {
+			values = append(values, input.Unwrap())
+		}
+	}
+	return values, errs
+}
+
+// Same as Partition() except that every error records the position of its input.
+func PartitionIndexed[T any](inputs []Result[T]) ([]T, []*IndexedError) {
+	var values []T
+	var errs []*IndexedError
+	for i, input := range inputs {
+		if input.IsErr() {
+			errs = append(errs, &IndexedError{i, input.UnwrapErr()})
+		} else {
+			values = append(values, input.Unwrap())
+		}
+	}
+	return values, errs
 }
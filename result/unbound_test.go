@@ -2,6 +2,11 @@ package result
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
 	. "github.com/Sh1kharGupta/easyerror"
 	. "github.com/Sh1kharGupta/easyerror/test_utils"
@@ -30,7 +35,7 @@ func TestUnbound(t *testing.T) {
 		}
 		return err
 	}
-	Assert(func1(0).UnwrapErr() == myError2) // Should be caught.
+	Assert(errors.Is(func1(0).UnwrapErr(), myError2)) // Should be caught.
 	Assert(func1(1).UnwrapErr().Error() == "expect panic: MyError2") // Should be caught.
 	Assert(Recover[string](func() {func1(2)}) == "raw panic")
 	Assert(func1(3).Unwrap() == 123)
@@ -62,4 +67,198 @@ func TestUnbound(t *testing.T) {
 	}
 	Assert(Convert[int](func3(0)).Unwrap() == 123)
 	Assert(Convert[int](func3(1)).UnwrapErr() == myError)
+	Assert(errors.Is(NewErr[int](myError).UnwrapErr(), myError))
+}
+
+func TestParity(t *testing.T) {
+	myError := errors.New("MyError")
+	ok := &Ok[int]{123}
+	err := &Err[int]{myError}
+	Assert(Flatten[int](&Ok[Result[int]]{ok}) == ok)
+	Assert(Flatten[int](&Ok[Result[int]]{err}) == err)
+	Assert(Flatten[int](&Err[Result[int]]{myError}).UnwrapErr() == myError)
+	// Flatten(Map(x, f)) is AndThen(x, f).
+	func1 := func(x int) Result[string] {return &Ok[string]{strconv.Itoa(x)}}
+	for _, input := range []Result[int]{ok, err} {
+		Assert(Flatten[string](Map[int, Result[string]](input, func1)).UnwrapOr("") == AndThen[int, string](input, func1).UnwrapOr(""))
+	}
+
+	value := 123
+	Assert(Copied[int](&Ok[*int]{&value}).Unwrap() == 123)
+	Assert(Copied[int](&Err[*int]{myError}).UnwrapErr() == myError)
+
+	Assert(MapErrE[int, string](ok, error.Error).Unwrap() == 123)
+	Assert(MapErrE[int, string](err, error.Error).UnwrapErr() == "MyError")
+}
+
+func TestStack(t *testing.T) {
+	defer func(old bool) {CaptureStack = old}(CaptureStack)
+	CaptureStack = true
+	myError := errors.New("MyError")
+	var stackErr *StackError
+	Assert(errors.As(Convert[int](0, myError).UnwrapErr(), &stackErr))
+	Assert(errors.As(NewErr[int](myError).UnwrapErr(), &stackErr))
+	Assert(errors.Is(stackErr, myError))
+	Assert(Convert[int](123, nil).Unwrap() == 123)
+}
+
+func TestSequence(t *testing.T) {
+	myError := errors.New("MyError")
+	myError2 := errors.New("MyError2")
+	ok := &Ok[int]{1}
+	ok2 := &Ok[int]{2}
+	err := &Err[int]{myError}
+	err2 := &Err[int]{myError2}
+	Assert(reflect.DeepEqual(Sequence[int]([]Result[int]{ok, ok2}).Unwrap(), []int{1, 2}))
+	Assert(reflect.DeepEqual(Sequence[int](nil).Unwrap(), []int{}))
+	Assert(Sequence[int]([]Result[int]{ok, err, err2}).UnwrapErr() == myError)
+	Assert(reflect.DeepEqual(SequenceAll[int]([]Result[int]{ok, ok2}).Unwrap(), []int{1, 2}))
+	joined := SequenceAll[int]([]Result[int]{err, ok, err2}).UnwrapErr()
+	Assert(errors.Is(joined, myError) && errors.Is(joined, myError2))
+	Assert(joined.Error() == "MyError\nMyError2")
+
+	calls := 0
+	func1 := func(x int) Result[string] {
+		calls++
+		if x < 0 {
+			return &Err[string]{myError}
+		}
+		return &Ok[string]{string(rune('a' + x))}
+	}
+	Assert(reflect.DeepEqual(Traverse[int, string]([]int{0, 1}, func1).Unwrap(), []string{"a", "b"}))
+	calls = 0
+	Assert(Traverse[int, string]([]int{-1, 0, 1}, func1).UnwrapErr() == myError)
+	Assert(calls == 1) // Stops at the first Err.
+	Assert(reflect.DeepEqual(TraverseAll[int, string]([]int{0, 1}, func1).Unwrap(), []string{"a", "b"}))
+	calls = 0
+	Assert(errors.Is(TraverseAll[int, string]([]int{-1, 0, -1}, func1).UnwrapErr(), myError))
+	Assert(calls == 3)
+
+	Assert(reflect.DeepEqual(SequenceMap[string, int](map[string]Result[int]{"x": ok, "y": ok2}).Unwrap(), map[string]int{"x": 1, "y": 2}))
+	Assert(SequenceMap[string, int](map[string]Result[int]{"x": ok, "y": err}).UnwrapErr() == myError)
+	func2 := func(key string, x int) Result[string] {
+		if x < 0 {
+			return &Err[string]{myError}
+		}
+		return &Ok[string]{key}
+	}
+	Assert(reflect.DeepEqual(TraverseMap[string, int, string](map[string]int{"x": 1}, func2).Unwrap(), map[string]string{"x": "x"}))
+	Assert(TraverseMap[string, int, string](map[string]int{"x": 1, "y": -1}, func2).UnwrapErr() == myError)
+}
+
+func TestPartition(t *testing.T) {
+	myError := errors.New("MyError")
+	myError2 := errors.New("MyError2")
+	inputs := []Result[int]{&Ok[int]{1}, &Err[int]{myError}, &Ok[int]{2}, &Err[int]{myError2}}
+	values, errs := Partition[int](inputs)
+	Assert(reflect.DeepEqual(values, []int{1, 2}))
+	Assert(reflect.DeepEqual(errs, []error{myError, myError2}))
+	values, indexedErrs := PartitionIndexed[int](inputs)
+	Assert(reflect.DeepEqual(values, []int{1, 2}))
+	Assert(len(indexedErrs) == 2)
+	Assert(indexedErrs[0].Index == 1 && indexedErrs[0].Err == myError)
+	Assert(indexedErrs[1].Index == 3 && errors.Is(indexedErrs[1], myError2))
+	Assert(indexedErrs[1].Error() == "index 3: MyError2")
+	values, errs = Partition[int](nil)
+	Assert(values == nil && errs == nil)
+}
+
+func TestCatchForeignPanics(t *testing.T) {
+	myError := errors.New("MyError")
+	foreign := &struct{Error error}{myError}
+	func1 := func(condition int) (ret Result[int]) {
+		defer Catch[int](&ret)
+		switch condition {
+		case 0:
+			panic(foreign)
+		case 1:
+			(&None[int]{}).Unwrap()
+		case 2:
+			(&ErrE[int, string]{"MyError"}).Unwrap()
+		case 3:
+			ErrV[int](myError).Expect("expect panic")
+		case 4:
+			(&Err[int]{nil}).Unwrap()
+		case 5:
+			(&None[int]{}).Expect("no value")
+		case 6:
+			(&Ok[int]{123}).ExpectErr("should fail")
+		case 7:
+			(&ErrE[int, error]{myError}).Expect("context")
+		}
+		return &Ok[int]{123}
+	}
+	Assert(Recover[*struct{Error error}](func() {func1(0)}) == foreign) // Re-raised as is.
+	Assert(errors.Is(func1(1).UnwrapErr(), ErrNone)) // None{} panics are caught too.
+	Assert(Recover[error](func() {func1(2)}).Error() == "MyError")
+	Assert(errors.Is(func1(3).UnwrapErr(), myError))
+	Assert(errors.Is(func1(4).UnwrapErr(), ErrNilError))
+	err := func1(5).UnwrapErr()
+	Assert(errors.Is(err, ErrNone) && err.Error() == "no value: " + ErrNone.Error())
+	err = func1(6).UnwrapErr()
+	Assert(errors.Is(err, ErrOk) && err.Error() == "should fail: " + ErrOk.Error() + ": 123")
+	err = func1(7).UnwrapErr()
+	Assert(errors.Is(err, myError) && err.Error() == "context: MyError")
+}
+
+func TestCatchErr(t *testing.T) {
+	myError := errors.New("MyError")
+	func1 := func(condition int) (value int, err error) {
+		defer CatchErr(&err)
+		switch condition {
+		case 0:
+			(&Err[string]{myError}).Unwrap()
+		case 1:
+			(&None[string]{}).Unwrap()
+		case 2:
+			panic("raw panic")
+		case 3:
+			(&Err[string]{nil}).Unwrap()
+		}
+		return 123, nil
+	}
+	value, err := func1(0)
+	Assert(value == 0 && errors.Is(err, myError))
+	_, err = func1(1)
+	Assert(errors.Is(err, ErrNone))
+	Assert(Recover[string](func() {func1(2)}) == "raw panic")
+	_, err = func1(3)
+	Assert(errors.Is(err, ErrNilError)) // Never reported as a success.
+	value, err = func1(4)
+	Assert(value == 123 && err == nil)
+
+	Assert(Try[int](func() int {return 123}).Unwrap() == 123)
+	Assert(errors.Is(Try[int](func() int {return (&Err[int]{myError}).Unwrap()}).UnwrapErr(), myError))
+	Assert(Recover[string](func() {Try[int](func() int {panic("raw panic")})}) == "raw panic")
+}
+
+func TestSite(t *testing.T) {
+	myError := errors.New("MyError")
+	var line int
+	func1 := func(condition int) (ret Result[int]) {
+		defer Catch[int](&ret)
+		(&Ok[int]{1}).Unwrap()
+		switch condition {
+		case 0:
+			_, _, line, _ = runtime.Caller(0); (&Err[int]{myError}).Unwrap()
+		case 1:
+			_, _, line, _ = runtime.Caller(0); (&Err[int]{myError}).Expect("expect panic")
+		case 2:
+			_, _, line, _ = runtime.Caller(0); (&None[int]{}).Unwrap()
+		case 3:
+			_, _, line, _ = runtime.Caller(0); ErrV[int](myError).Unwrap()
+		case 4:
+			_, _, line, _ = runtime.Caller(0); NoneV[int]().Unwrap()
+		}
+		return &Ok[int]{123}
+	}
+	for condition := 0; condition < 5; condition++ {
+		var siteErr *SiteError
+		err := func1(condition).UnwrapErr()
+		Assert(errors.As(err, &siteErr))
+		file, siteLine := siteErr.Site()
+		Assert(strings.HasSuffix(file, "unbound_test.go") && siteLine == line)
+		Assert(fmt.Sprintf("%v", err) == err.Error())
+		Assert(fmt.Sprintf("%+v", err) == fmt.Sprintf("%s\nunwrapped at %s:%d", err, file, line))
+	}
 }
@@ -2,7 +2,10 @@ package easyerror
 
 import (
     "errors"
+    "slices"
+    "strconv"
     "testing"
+    "github.com/Sh1kharGupta/easyerror/internal/sentinel"
     . "github.com/Sh1kharGupta/easyerror/test_utils"
 )
 
@@ -15,11 +18,11 @@ func TestResult(t *testing.T) {
     Assert(!ok.IsErr())
     Assert(err.IsErr())
     Assert(ok.Expect("no panic") == 123)
-    recoveredErr := Recover[*Err[int]](func() {err.Expect("panic")}).UnwrapErr()
+    recoveredErr := Recover[*sentinel.Panic](func() {err.Expect("panic")}).Err.(error)
     Assert(errors.Unwrap(recoveredErr) == myError)
     Assert(recoveredErr.Error() == "panic: MyError")
     Assert(ok.Unwrap() == 123)
-    Assert(Recover[*Err[int]](func() {err.Unwrap()}) == err)
+    Assert(Recover[*sentinel.Panic](func() {err.Unwrap()}).Err == myError)
     Assert(ok.UnwrapOr(456) == 123)
     Assert(err.UnwrapOr(456) == 456)
     Assert(ok.UnwrapOrElse(func() int {return 456}) == 123)
@@ -60,3 +63,45 @@ func TestResult(t *testing.T) {
     Assert(err.OrElse(func(error) Result[int] {return ok2}) == ok2)
     Assert(err.OrElse(func(error) Result[int] {return err2}) == err2)
 }
+
+func TestResultIter(t *testing.T) {
+    values := []int{}
+    for value := range (&Ok[int]{123}).Iter() {
+        values = append(values, value)
+    }
+    for value := range (&Err[int]{errors.New("MyError")}).Iter() {
+        values = append(values, value)
+    }
+    for value := range (&OkE[int, string]{456}).Iter() {
+        values = append(values, value)
+    }
+    for value := range (&ErrE[int, string]{"MyError"}).Iter() {
+        values = append(values, value)
+    }
+    Assert(len(values) == 2 && values[0] == 123 && values[1] == 456)
+}
+
+// Checks the methods matching Rust's is_ok_and, is_err_and, inspect,
+// inspect_err, expect_err and unwrap_or_default against their definitions.
+func TestResultLaws(t *testing.T) {
+    myError := errors.New("MyError")
+    even := func(x int) bool {return x % 2 == 0}
+    isMyError := func(err error) bool {return err == myError}
+    for _, res := range []Result[int]{&Ok[int]{2}, &Ok[int]{3}, &Err[int]{myError}, &Err[int]{errors.New("Other")}} {
+        Assert(res.IsOkAnd(even) == res.Ok().IsSomeAnd(even))
+        Assert(res.IsErrAnd(isMyError) == res.Err().IsSomeAnd(isMyError))
+        Assert(res.UnwrapOrDefault() == res.UnwrapOr(0))
+        var values []int
+        var errs []error
+        Assert(res.Inspect(func(x int) {values = append(values, x)}) == res)
+        Assert(res.InspectErr(func(err error) {errs = append(errs, err)}) == res)
+        Assert(len(values) == len(slices.Collect(res.Iter())) && len(errs) == len(slices.Collect(res.Err().Iter())))
+        if res.IsErr() {
+            Assert(res.ExpectErr("msg") == res.UnwrapErr() && errs[0] == res.UnwrapErr())
+        } else {
+            Assert(values[0] == res.Unwrap())
+            err := Recover[*sentinel.Panic](func() {res.ExpectErr("msg")}).Err.(error)
+            Assert(errors.Is(err, ErrOk) && err.Error() == "msg: " + ErrOk.Error() + ": " + strconv.Itoa(res.Unwrap()))
+        }
+    }
+}
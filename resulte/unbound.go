@@ -0,0 +1,144 @@
+package resulte
+
+import (
+	"fmt"
+	. "github.com/Sh1kharGupta/easyerror"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+)
+
+// Can catch panics by Unwrap() or Expect() on ErrE{Error} with the same E.
+// Panics by Unwrap() or Expect() on Err{Error}, and by Unwrap() on None{} giving
+// Err{easyerror.ErrNone}, are caught as well when E is error.
+// When E is error, the caught error is wrapped in an easyerror.SiteError
+// recording where the call was, and in the message given to ErrE.Expect().
+// Any other panic is re-raised with its original value.
+func Catch[T, E any](ret *ResultE[T, E]) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	p, ok := r.(*sentinel.Panic)
+	if !ok {
+		panic(r)
+	}
+	e, ok := p.Err.(E)
+	if !ok && p.Err != nil {
+		panic(r)
+	}
+	if err, ok := p.Err.(error); ok && err != nil {
+		if p.Msg != "" { // ErrE.Expect() with an error as E.
+			err = fmt.Errorf("%s: %w", p.Msg, err)
+		}
+		// Only possible when E is an interface type which SiteError implements, e.g. error.
+		if site, ok := any(&SiteError{err, p.PC}).(E); ok {
+			e = site
+		}
+	}
+	*ret = &ErrE[T, E]{e}
+}
+
+// Ok{Some{Value}} -> Some{Ok{Value}}
+// Ok{None{}} -> None{}
+// Err{Error} -> Some{Err{Error}}
+func Transpose[T, E any](input ResultE[Option[T], E]) Option[ResultE[T, E]] {
+	if input.IsOk() {
+		if input.Unwrap().IsSome() {
+			return &Some[ResultE[T, E]]{&OkE[T, E]{input.Unwrap().Unwrap()}}
+		}
+		return &None[ResultE[T, E]]{}
+	}
+	return &Some[ResultE[T, E]]{&ErrE[T, E]{input.UnwrapErr()}}
+}
+
+// Similar to the bound Map() method except this one can work with multiple types.
+// Please see the `ResultE` interface for further documentation.
+func Map[T1, T2, E any](input ResultE[T1, E], transformFunc func(T1) T2) ResultE[T2, E] {
+	if input.IsOk() {
+		return &OkE[T2, E]{transformFunc(input.Unwrap())}
+	}
+	return &ErrE[T2, E]{input.UnwrapErr()}
+}
+
+// Similar to the bound MapErr() method except this one can change the error type.
+// Please see the `ResultE` interface for further documentation.
+func MapErr[T, E1, E2 any](input ResultE[T, E1], transformFunc func(E1) E2) ResultE[T, E2] {
+	if input.IsOk() {
+		return &OkE[T, E2]{input.Unwrap()}
+	}
+	return &ErrE[T, E2]{transformFunc(input.UnwrapErr())}
+}
+
+// Similar to the bound MapOr() method except this one can work with multiple types.
+// Please see the `ResultE` interface for further documentation.
+func MapOr[T1, T2, E any](input ResultE[T1, E], defaultValue T2, transformFunc func(T1) T2) T2 {
+	if input.IsOk() {
+		return transformFunc(input.Unwrap())
+	}
+	return defaultValue
+}
+
+// Similar to the bound MapOrElse() method except this one can work with multiple types.
+// Please see the `ResultE` interface for further documentation.
+func MapOrElse[T1, T2, E any](input ResultE[T1, E], defaultFunc func() T2, transformFunc func(T1) T2) T2 {
+	if input.IsOk() {
+		return transformFunc(input.Unwrap())
+	}
+	return defaultFunc()
+}
+
+// Similar to the bound And() method except this one can work with multiple types.
+// Please see the `ResultE` interface for further documentation.
+func And[T1, T2, E any](first ResultE[T1, E], second ResultE[T2, E]) ResultE[T2, E] {
+	if first.IsOk() {
+		return second
+	}
+	return &ErrE[T2, E]{first.UnwrapErr()}
+}
+
+// Similar to the bound AndThen() method except this one can work with multiple types.
+// Please see the `ResultE` interface for further documentation.
+func AndThen[T1, T2, E any](first ResultE[T1, E], transformFunc func(T1) ResultE[T2, E]) ResultE[T2, E] {
+	if first.IsErr() {
+		return &ErrE[T2, E]{first.UnwrapErr()}
+	}
+	return transformFunc(first.Unwrap())
+}
+
+// Convert a function's return (value T, err E) to:-
+// Ok{value} if err is the zero value of E (e.g. a nil pointer)
+// Err{err} otherwise
+func Convert[T any, E comparable](value T, err E) ResultE[T, E] {
+	var zero E
+	if err == zero {
+		return &OkE[T, E]{value}
+	}
+	return &ErrE[T, E]{err}
+}
+
+// Ok{Value} -> OkE{Value}
+// Err{Error} -> ErrE{Error}
+func FromResult[T any](input Result[T]) ResultE[T, error] {
+	if input.IsOk() {
+		return &OkE[T, error]{input.Unwrap()}
+	}
+	return &ErrE[T, error]{input.UnwrapErr()}
+}
+
+// OkE{Value} -> Ok{Value}
+// ErrE{Error} -> Err{Error}
+func ToResult[T any, E error](input ResultE[T, E]) Result[T] {
+	if input.IsOk() {
+		return &Ok[T]{input.Unwrap()}
+	}
+	return &Err[T]{input.UnwrapErr()}
+}
+
+// OkE{Value} -> Ok{Value}
+// ErrE{Error} -> Err{func(Error)}
+// > for error types which do not implement the error interface themselves.
+func ToResultFunc[T, E any](input ResultE[T, E], errorFunc func(E) error) Result[T] {
+	if input.IsOk() {
+		return &Ok[T]{input.Unwrap()}
+	}
+	return &Err[T]{errorFunc(input.UnwrapErr())}
+}
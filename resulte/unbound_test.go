@@ -0,0 +1,118 @@
+package resulte
+
+import (
+	"errors"
+	"testing"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+type myError struct {
+	Code int
+}
+
+func (self *myError) Error() string {
+	return "MyError"
+}
+
+func TestUnbound(t *testing.T) {
+	ok := &OkE[int, *myError]{123}
+	myError1 := &myError{1}
+	myError2 := &myError{2}
+	ok2 := &OkE[string, *myError]{"test"}
+	err2 := &ErrE[string, *myError]{myError2}
+	err := &ErrE[int, *myError]{myError1}
+
+	func1 := func(condition int) (ret ResultE[int, *myError]) {
+		defer Catch[int, *myError](&ret)
+		switch condition {
+		case 0:
+			err2.Unwrap()
+		case 1:
+			err2.Expect("expect panic")
+		case 2:
+			panic("raw panic")
+		case 3:
+			ok2.Unwrap()
+			return ok
+		}
+		return err
+	}
+	Assert(func1(0).UnwrapErr() == myError2) // Should be caught.
+	Assert(func1(1).UnwrapErr() == myError2) // Should be caught.
+	Assert(Recover[string](func() {func1(2)}) == "raw panic")
+	Assert(func1(3).Unwrap() == 123)
+	plainError := errors.New("PlainError")
+	func2 := func() (ret ResultE[int, error]) {
+		defer Catch[int, error](&ret)
+		(&Err[string]{plainError}).Unwrap()
+		return &OkE[int, error]{123}
+	}
+	Assert(errors.Is(func2().UnwrapErr(), plainError)) // Err{} panics are caught when E is error.
+	func8 := func() (ret ResultE[int, error]) {
+		defer Catch[int, error](&ret)
+		(&None[int]{}).Unwrap()
+		return &OkE[int, error]{123}
+	}
+	Assert(errors.Is(func8().UnwrapErr(), ErrNone)) // None{} panics as well.
+	func9 := func() (ret ResultE[int, error]) {
+		defer Catch[int, error](&ret)
+		(&ErrE[int, error]{plainError}).Expect("context")
+		return &OkE[int, error]{123}
+	}
+	caught := func9().UnwrapErr()
+	Assert(errors.Is(caught, plainError) && caught.Error() == "context: PlainError") // The message is kept.
+	func7 := func(condition int) (ret ResultE[int, *myError]) {
+		defer Catch[int, *myError](&ret)
+		switch condition {
+		case 0:
+			(&ErrE[int, string]{"MyError"}).Unwrap()
+		case 1:
+			(&None[int]{}).Unwrap()
+		case 2:
+			(&ErrE[int, *myError]{nil}).Unwrap()
+		}
+		return ok
+	}
+	Assert(Recover[error](func() {func7(0)}).Error() == "MyError") // A different E is re-raised.
+	Assert(Recover[error](func() {func7(1)}) != nil)
+	Assert(func7(2).IsErr() && func7(2).UnwrapErr() == nil)
+	Assert(Transpose[int, *myError](&OkE[Option[int], *myError]{&Some[int]{123}}).Unwrap().Unwrap() == 123)
+	Assert(Transpose[int, *myError](&OkE[Option[int], *myError]{&None[int]{}}).IsNone())
+	Assert(Transpose[int, *myError](&ErrE[Option[int], *myError]{myError1}).Unwrap().UnwrapErr() == myError1)
+	func3 := func(int) string {return "test"}
+	Assert(Map[int, string, *myError](ok, func3).Unwrap() == "test")
+	Assert(Map[int, string, *myError](err, func3).UnwrapErr() == myError1)
+	func4 := func(e *myError) int {return e.Code}
+	Assert(MapErr[int, *myError, int](ok, func4).Unwrap() == 123)
+	Assert(MapErr[int, *myError, int](err, func4).UnwrapErr() == 1)
+	Assert(MapOr[int, string, *myError](ok, "default", func3) == "test")
+	Assert(MapOr[int, string, *myError](err, "default", func3) == "default")
+	Assert(MapOrElse[int, string, *myError](ok, func() string {return "default"}, func3) == "test")
+	Assert(MapOrElse[int, string, *myError](err, func() string {return "default"}, func3) == "default")
+	Assert(And[int, string, *myError](ok, ok2) == ok2)
+	Assert(And[int, string, *myError](ok, err2) == err2)
+	Assert(And[int, string, *myError](err, ok2).UnwrapErr() == myError1)
+	Assert(And[int, string, *myError](err, err2).UnwrapErr() == myError1)
+	Assert(AndThen[int, string, *myError](ok, func(int) ResultE[string, *myError] {return ok2}) == ok2)
+	Assert(AndThen[int, string, *myError](ok, func(int) ResultE[string, *myError] {return err2}) == err2)
+	Assert(AndThen[int, string, *myError](err, func(int) ResultE[string, *myError] {return ok2}).UnwrapErr() == myError1)
+	Assert(AndThen[int, string, *myError](err, func(int) ResultE[string, *myError] {return err2}).UnwrapErr() == myError1)
+	func5 := func(condition int) (int, *myError) {
+		switch condition {
+		case 0:
+			return 123, nil
+		default:
+			return 0, myError1
+		}
+	}
+	Assert(Convert[int, *myError](func5(0)).Unwrap() == 123)
+	Assert(Convert[int, *myError](func5(1)).UnwrapErr() == myError1)
+	Assert(FromResult[int](&Ok[int]{123}).Unwrap() == 123)
+	Assert(FromResult[int](&Err[int]{plainError}).UnwrapErr() == plainError)
+	Assert(ToResult[int, *myError](ok).Unwrap() == 123)
+	Assert(ToResult[int, *myError](err).UnwrapErr() == myError1)
+	func6 := func(e *myError) error {return plainError}
+	Assert(ToResultFunc[int, *myError](ok, func6).Unwrap() == 123)
+	Assert(ToResultFunc[int, *myError](err, func6).UnwrapErr() == plainError)
+}
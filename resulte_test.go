@@ -0,0 +1,75 @@
+package easyerror
+
+import (
+	"testing"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+type myErrorE struct {
+	Code int
+}
+
+func TestResultE(t *testing.T) {
+	ok := &OkE[int, myErrorE]{123}
+	myError := myErrorE{1}
+	err := &ErrE[int, myErrorE]{myError}
+	Assert(ok.IsOk())
+	Assert(!err.IsOk())
+	Assert(!ok.IsErr())
+	Assert(err.IsErr())
+	Assert(ok.Expect("no panic") == 123)
+	recovered := Recover[*sentinel.Panic](func() {err.Expect("panic")})
+	Assert(recovered.Err == myError)
+	Assert(recovered.Error() == "panic: {1}")
+	Assert(ok.Unwrap() == 123)
+	Assert(Recover[*sentinel.Panic](func() {err.Unwrap()}).Err == myError)
+	Assert(ok.UnwrapOr(456) == 123)
+	Assert(err.UnwrapOr(456) == 456)
+	Assert(ok.UnwrapOrElse(func() int {return 456}) == 123)
+	Assert(err.UnwrapOrElse(func() int {return 456}) == 456)
+	Assert(Recover[string](func() {ok.UnwrapErr()}) == "Can't UnwrapErr on Ok!")
+	Assert(err.UnwrapErr() == myError)
+	Assert(ok.Err().IsNone())
+	Assert(err.Err().Unwrap() == myError)
+	Assert(ok.Ok().Unwrap() == 123)
+	Assert(err.Ok().IsNone())
+	func1 := func(x int) int {return x * 2}
+	myError2 := myErrorE{2}
+	func2 := func(x myErrorE) myErrorE {return myError2}
+	Assert(ok.Map(func1).Unwrap() == 246)
+	Assert(err.Map(func1) == err)
+	Assert(ok.MapErr(func2) == ok)
+	Assert(err.MapErr(func2).UnwrapErr() == myError2)
+	Assert(ok.MapOr(456, func1) == 246)
+	Assert(err.MapOr(456, func1) == 456)
+	Assert(ok.MapOrElse(func() int {return 456}, func1) == 246)
+	Assert(err.MapOrElse(func() int {return 456}, func1) == 456)
+	ok2 := &OkE[int, myErrorE]{456}
+	err2 := &ErrE[int, myErrorE]{myError2}
+	Assert(ok.And(ok2) == ok2)
+	Assert(ok.And(err2) == err2)
+	Assert(err.And(ok2) == err)
+	Assert(err.And(err2) == err)
+	Assert(ok.Or(ok2) == ok)
+	Assert(ok.Or(err2) == ok)
+	Assert(err.Or(ok2) == ok2)
+	Assert(err.Or(err2) == err2)
+	Assert(ok.AndThen(func(int) ResultE[int, myErrorE] {return ok2}) == ok2)
+	Assert(ok.AndThen(func(int) ResultE[int, myErrorE] {return err2}) == err2)
+	Assert(err.AndThen(func(int) ResultE[int, myErrorE] {return ok2}) == err)
+	Assert(err.AndThen(func(int) ResultE[int, myErrorE] {return err2}) == err)
+	Assert(ok.OrElse(func(myErrorE) ResultE[int, myErrorE] {return ok2}) == ok)
+	Assert(ok.OrElse(func(myErrorE) ResultE[int, myErrorE] {return err2}) == ok)
+	Assert(err.OrElse(func(myErrorE) ResultE[int, myErrorE] {return ok2}) == ok2)
+	Assert(err.OrElse(func(myErrorE) ResultE[int, myErrorE] {return err2}) == err2)
+	Assert(ok.IsOkAnd(func(x int) bool {return x == 123}) && !err.IsOkAnd(func(int) bool {return true}))
+	Assert(err.IsErrAnd(func(e myErrorE) bool {return e.Code == 1}) && !ok.IsErrAnd(func(myErrorE) bool {return true}))
+	Assert(ok.UnwrapOrDefault() == 123 && err.UnwrapOrDefault() == 0)
+	Assert(err.ExpectErr("no panic") == myError)
+	Assert(Recover[*sentinel.Panic](func() {ok.ExpectErr("msg")}).Err.(error).Error() == "msg: " + ErrOk.Error() + ": 123")
+	inspected, inspectedErr := 0, myErrorE{}
+	Assert(ok.Inspect(func(x int) {inspected += x}) == ok && err.Inspect(func(x int) {inspected += x}) == err)
+	Assert(ok.InspectErr(func(e myErrorE) {inspectedErr = e}) == ok && err.InspectErr(func(e myErrorE) {inspectedErr = e}) == err)
+	Assert(inspected == 123 && inspectedErr == myError)
+}
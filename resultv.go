@@ -0,0 +1,207 @@
+package easyerror
+
+import (
+	"fmt"
+	"iter"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+)
+
+// Allocation-free alternative to the Result interface. Has the same methods
+// as Result but is passed around by value, so unlike Ok{} and Err{} it does
+// not escape to the heap. The zero value is OkV() of the zero value of T.
+// See the Result interface for documentation of methods.
+type ResultV[T any] struct {
+	value T
+	err error
+	isErr bool // Set even when err is nil, like for Err{nil}.
+}
+
+// Returns a ResultV holding the given value.
+func OkV[T any](value T) ResultV[T] {
+	return ResultV[T]{value, nil, false}
+}
+
+// Returns a ResultV holding the given error.
+// > like Err{nil}, ErrV(nil) is an error holding nil, not OkV().
+func ErrV[T any](err error) ResultV[T] {
+	return ResultV[T]{err: err, isErr: true}
+}
+
+// Ok{Value} -> OkV(Value)
+// Err{Error} -> ErrV(Error)
+func ToResultV[T any](input Result[T]) ResultV[T] {
+	if input.IsOk() {
+		return ResultV[T]{input.Unwrap(), nil, false}
+	}
+	return ResultV[T]{err: input.UnwrapErr(), isErr: true}
+}
+
+// OkV(Value) -> Ok{Value}
+// ErrV(Error) -> Err{Error}
+func (self ResultV[T]) Result() Result[T] {
+	if !self.isErr {
+		return &Ok[T]{self.value}
+	}
+	return &Err[T]{self.err}
+}
+
+func (self ResultV[T]) IsOk() bool {
+	return !self.isErr
+}
+
+func (self ResultV[T]) IsErr() bool {
+	return self.isErr
+}
+
+func (self ResultV[T]) IsOkAnd(predicate func(T) bool) bool {
+	return !self.isErr && predicate(self.value)
+}
+
+func (self ResultV[T]) IsErrAnd(predicate func(error) bool) bool {
+	return self.isErr && predicate(self.err)
+}
+
+// Panics the same way as Err.Expect() so that result.Catch() works.
+func (self ResultV[T]) Expect(msg string) T {
+	if self.isErr {
+		panic(&sentinel.Panic{Err: withStack(fmt.Errorf("%s: %w", msg, self.err), 1), PC: sentinel.Caller()})
+	}
+	return self.value
+}
+
+// Panics the same way as Err.Unwrap() so that result.Catch() works.
+func (self ResultV[T]) Unwrap() T {
+	if self.isErr {
+		panic(&sentinel.Panic{Err: self.err, PC: sentinel.Caller()})
+	}
+	return self.value
+}
+
+func (self ResultV[T]) UnwrapOr(defaultValue T) T {
+	if !self.isErr {
+		return self.value
+	}
+	return defaultValue
+}
+
+func (self ResultV[T]) UnwrapOrElse(defaultFunc func() T) T {
+	if !self.isErr {
+		return self.value
+	}
+	return defaultFunc()
+}
+
+func (self ResultV[T]) UnwrapOrDefault() T {
+	if !self.isErr {
+		return self.value
+	}
+	var zero T
+	return zero
+}
+
+func (self ResultV[T]) UnwrapErr() error {
+	if !self.isErr {
+		panic("Can't UnwrapErr on Ok!")
+	}
+	return self.err
+}
+
+// Panics the same way as Ok.ExpectErr() so that result.Catch() works.
+func (self ResultV[T]) ExpectErr(msg string) error {
+	if !self.isErr {
+		panic(&sentinel.Panic{Err: withStack(fmt.Errorf("%s: %w: %v", msg, ErrOk, self.value), 1), PC: sentinel.Caller()})
+	}
+	return self.err
+}
+
+func (self ResultV[T]) Err() OptionV[error] {
+	if !self.isErr {
+		return OptionV[error]{}
+	}
+	return OptionV[error]{self.err, true}
+}
+
+func (self ResultV[T]) Ok() OptionV[T] {
+	if !self.isErr {
+		return OptionV[T]{self.value, true}
+	}
+	return OptionV[T]{}
+}
+
+func (self ResultV[T]) Map(transformFunc func(T) T) ResultV[T] {
+	if !self.isErr {
+		return ResultV[T]{transformFunc(self.value), nil, false}
+	}
+	return self
+}
+
+func (self ResultV[T]) MapErr(transformFunc func(error) error) ResultV[T] {
+	if !self.isErr {
+		return self
+	}
+	return ResultV[T]{err: transformFunc(self.err), isErr: true}
+}
+
+func (self ResultV[T]) MapOr(defaultValue T, transformFunc func(T) T) T {
+	if !self.isErr {
+		return transformFunc(self.value)
+	}
+	return defaultValue
+}
+
+func (self ResultV[T]) MapOrElse(defaultFunc func() T, transformFunc func(T) T) T {
+	if !self.isErr {
+		return transformFunc(self.value)
+	}
+	return defaultFunc()
+}
+
+func (self ResultV[T]) And(second ResultV[T]) ResultV[T] {
+	if !self.isErr {
+		return second
+	}
+	return self
+}
+
+func (self ResultV[T]) Or(second ResultV[T]) ResultV[T] {
+	if !self.isErr {
+		return self
+	}
+	return second
+}
+
+func (self ResultV[T]) AndThen(transformFunc func(T) ResultV[T]) ResultV[T] {
+	if !self.isErr {
+		return transformFunc(self.value)
+	}
+	return self
+}
+
+func (self ResultV[T]) OrElse(transformFunc func(error) ResultV[T]) ResultV[T] {
+	if !self.isErr {
+		return self
+	}
+	return transformFunc(self.err)
+}
+
+func (self ResultV[T]) Inspect(inspectFunc func(T)) ResultV[T] {
+	if !self.isErr {
+		inspectFunc(self.value)
+	}
+	return self
+}
+
+func (self ResultV[T]) InspectErr(inspectFunc func(error)) ResultV[T] {
+	if self.isErr {
+		inspectFunc(self.err)
+	}
+	return self
+}
+
+func (self ResultV[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if !self.isErr {
+			yield(self.value)
+		}
+	}
+}
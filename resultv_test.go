@@ -0,0 +1,157 @@
+package easyerror
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestResultV(t *testing.T) {
+	ok := OkV(123)
+	myError := errors.New("MyError")
+	err := ErrV[int](myError)
+	Assert(ResultV[int]{} == OkV(0))
+	// A nil error still makes an error, like Err{nil}.
+	Assert(ErrV[int](nil).IsErr() && ErrV[int](nil).UnwrapErr() == nil)
+	Assert(ToResultV[int](&Err[int]{nil}).IsErr())
+	Assert(err.MapErr(func(error) error {return nil}).IsErr())
+	Assert(ErrV[int](nil).Result().IsErr())
+	Assert(ok.IsOk())
+	Assert(!err.IsOk())
+	Assert(!ok.IsErr())
+	Assert(err.IsErr())
+	Assert(ok.Expect("no panic") == 123)
+	recoveredErr := Recover[*sentinel.Panic](func() {err.Expect("panic")}).Err.(error)
+	Assert(errors.Unwrap(recoveredErr) == myError)
+	Assert(recoveredErr.Error() == "panic: MyError")
+	Assert(ok.Unwrap() == 123)
+	Assert(Recover[*sentinel.Panic](func() {err.Unwrap()}).Err == myError)
+	Assert(ok.UnwrapOr(456) == 123)
+	Assert(err.UnwrapOr(456) == 456)
+	Assert(ok.UnwrapOrElse(func() int {return 456}) == 123)
+	Assert(err.UnwrapOrElse(func() int {return 456}) == 456)
+	Assert(Recover[string](func() {ok.UnwrapErr()}) == "Can't UnwrapErr on Ok!")
+	Assert(err.UnwrapErr() == myError)
+	Assert(ok.Err().IsNone())
+	Assert(err.Err().Unwrap() == myError)
+	Assert(ok.Ok().Unwrap() == 123)
+	Assert(err.Ok().IsNone())
+	func1 := func(x int) int {return x * 2}
+	myError2 := errors.New("MyError2")
+	func2 := func(x error) error {return myError2}
+	Assert(ok.Map(func1) == OkV(246))
+	Assert(err.Map(func1) == err)
+	Assert(ok.MapErr(func2) == ok)
+	Assert(err.MapErr(func2).UnwrapErr() == myError2)
+	Assert(ok.MapOr(456, func1) == 246)
+	Assert(err.MapOr(456, func1) == 456)
+	Assert(ok.MapOrElse(func() int {return 456}, func1) == 246)
+	Assert(err.MapOrElse(func() int {return 456}, func1) == 456)
+	ok2 := OkV(456)
+	err2 := ErrV[int](myError2)
+	Assert(ok.And(ok2) == ok2)
+	Assert(ok.And(err2) == err2)
+	Assert(err.And(ok2) == err)
+	Assert(ok.Or(err2) == ok)
+	Assert(err.Or(ok2) == ok2)
+	Assert(err.Or(err2) == err2)
+	Assert(ok.AndThen(func(int) ResultV[int] {return err2}) == err2)
+	Assert(err.AndThen(func(int) ResultV[int] {return ok2}) == err)
+	Assert(ok.OrElse(func(error) ResultV[int] {return err2}) == ok)
+	Assert(err.OrElse(func(error) ResultV[int] {return ok2}) == ok2)
+	count := 0
+	for value := range ok.Iter() {
+		count += value
+	}
+	for value := range err.Iter() {
+		count += value
+	}
+	Assert(count == 123)
+	Assert(ok.Result().Unwrap() == 123)
+	Assert(err.Result().UnwrapErr() == myError)
+	Assert(ToResultV[int](&Ok[int]{123}) == ok)
+	Assert(ToResultV[int](&Err[int]{myError}) == err)
+	Assert(ok.IsOkAnd(func(x int) bool {return x == 123}) && !err.IsOkAnd(func(int) bool {return true}))
+	Assert(err.IsErrAnd(func(e error) bool {return e == myError}) && !ok.IsErrAnd(func(error) bool {return true}))
+	Assert(ok.UnwrapOrDefault() == 123 && err.UnwrapOrDefault() == 0)
+	Assert(err.ExpectErr("no panic") == myError)
+	Assert(errors.Is(Recover[*sentinel.Panic](func() {ok.ExpectErr("msg")}).Err.(error), ErrOk))
+	inspected, inspectedErr := 0, error(nil)
+	Assert(ok.Inspect(func(x int) {inspected += x}) == ok && err.Inspect(func(x int) {inspected += x}) == err)
+	Assert(ok.InspectErr(func(e error) {inspectedErr = e}) == ok && err.InspectErr(func(e error) {inspectedErr = e}) == err)
+	Assert(inspected == 123 && inspectedErr == myError)
+}
+
+// The benchmarks below run the same parse-and-double pipeline with plain
+// (T, error) returns, the Result interface and ResultV.
+
+func parsePlain(s string) (int, error) {
+	return strconv.Atoi(s)
+}
+
+func parsePointer(s string) Result[int] {
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		return &Err[int]{err}
+	}
+	return &Ok[int]{value}
+}
+
+func parseValue(s string) ResultV[int] {
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		return ErrV[int](err)
+	}
+	return OkV(value)
+}
+
+func double(x int) int {
+	return x * 2
+}
+
+func BenchmarkPlainError(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		value, err := parsePlain("123")
+		if err != nil {
+			b.Fatal(err)
+		}
+		value = double(double(value))
+		if value != 492 {
+			b.Fatal(value)
+		}
+	}
+}
+
+func BenchmarkResultPointer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if parsePointer("123").Map(double).Map(double).UnwrapOr(0) != 492 {
+			b.Fatal()
+		}
+	}
+}
+
+func BenchmarkResultValue(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if parseValue("123").Map(double).Map(double).UnwrapOr(0) != 492 {
+			b.Fatal()
+		}
+	}
+}
+
+func BenchmarkOptionPointer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if parsePointer("123").Ok().Map(double).Map(double).UnwrapOr(0) != 492 {
+			b.Fatal()
+		}
+	}
+}
+
+func BenchmarkOptionValue(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if parseValue("123").Ok().Map(double).Map(double).UnwrapOr(0) != 492 {
+			b.Fatal()
+		}
+	}
+}
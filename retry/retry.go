@@ -0,0 +1,133 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// Returns the delay before the given retry, counting from 1.
+type Backoff func(retry int) time.Duration
+
+// Decides how Do() retries. The zero value retries every error immediately
+// and forever, so set at least MaxAttempts or MaxElapsed.
+type Policy struct {
+	// Stops after this many attempts. 0 means no limit.
+	MaxAttempts int
+	// Stops once this much time has passed since the first attempt, or would
+	// have passed after the next delay. 0 means no limit.
+	MaxElapsed time.Duration
+	// Delay between attempts. nil means no delay.
+	Backoff Backoff
+	// Reports whether an error is worth retrying. nil means every error is.
+	Retryable func(error) bool
+	// Time source of MaxElapsed. nil means time.Now.
+	Now func() time.Time
+	// Waits out the delays. nil means time.After.
+	After func(time.Duration) <-chan time.Time
+}
+
+// Same delay before every retry.
+func Constant(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// Delay doubling with every retry, starting from base and capped at max.
+func Exponential(base, max time.Duration) Backoff {
+	return func(retry int) time.Duration {
+		delay := base
+		for i := 1; i < retry && delay < max; i++ {
+			delay *= 2
+		}
+		return min(delay, max)
+	}
+}
+
+// Randomises the delays of the given backoff to within [1-fraction, 1+fraction]
+// of their value, so that many clients don't retry in lockstep.
+// rng may be nil to use math/rand's global source. Otherwise it is guarded by
+// a mutex, so the Backoff can be shared by a Policy used across goroutines.
+func Jitter(backoff Backoff, fraction float64, rng *rand.Rand) Backoff {
+	random := rand.Float64
+	if rng != nil {
+		var mutex sync.Mutex
+		random = func() float64 {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return rng.Float64()
+		}
+	}
+	return func(retry int) time.Duration {
+		delay := float64(backoff(retry))
+		return time.Duration(delay * (1 + fraction*(2*random()-1)))
+	}
+}
+
+// Error of Do() once the policy gives up. Wraps the error of every attempt.
+type Error struct {
+	Attempts int
+	Errors []error
+}
+
+func (self *Error) Error() string {
+	messages := make([]string, len(self.Errors))
+	for i, err := range self.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("gave up after %d attempts: %s", self.Attempts, strings.Join(messages, "; "))
+}
+
+func (self *Error) Unwrap() []error {
+	return self.Errors
+}
+
+// Runs the given function until it returns Ok{Value} or the policy gives up.
+// Ok{Value} -> Ok{Value}
+// Policy gives up -> Err{*Error} wrapping the Error of every attempt
+// ctx done before the first attempt -> Err{ctx.Err()} without calling the function
+// ctx done while waiting -> Err{*Error} also wrapping ctx.Err()
+func Do[T any](ctx context.Context, policy Policy, doFunc func(context.Context) Result[T]) Result[T] {
+	if policy.Now == nil {
+		policy.Now = time.Now
+	}
+	if policy.After == nil {
+		policy.After = time.After
+	}
+	if err := ctx.Err(); err != nil {
+		return &Err[T]{err}
+	}
+	start := policy.Now()
+	var errs []error
+	for attempt := 1; ; attempt++ {
+		res := doFunc(ctx)
+		if res.IsOk() {
+			return res
+		}
+		errs = append(errs, res.UnwrapErr())
+		if policy.Retryable != nil && !policy.Retryable(res.UnwrapErr()) {
+			break
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			break
+		}
+		var delay time.Duration
+		if policy.Backoff != nil {
+			delay = policy.Backoff(attempt)
+		}
+		if policy.MaxElapsed > 0 && policy.Now().Add(delay).Sub(start) >= policy.MaxElapsed {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return &Err[T]{&Error{attempt, append(errs, ctx.Err())}}
+		case <-policy.After(delay):
+		}
+	}
+	return &Err[T]{&Error{len(errs), errs}}
+}
@@ -0,0 +1,102 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+	. "github.com/Sh1kharGupta/easyerror"
+	"github.com/Sh1kharGupta/easyerror/internal/clock"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+// Returns a function failing with the given errors before returning Ok{123}.
+func failing(errs ...error) (func(context.Context) Result[int], *int) {
+	attempts := 0
+	return func(context.Context) Result[int] {
+		attempts++
+		if attempts <= len(errs) {
+			return &Err[int]{errs[attempts-1]}
+		}
+		return &Ok[int]{123}
+	}, &attempts
+}
+
+func TestDo(t *testing.T) {
+	myError := errors.New("MyError")
+	fatal := errors.New("Fatal")
+	ctx := context.Background()
+
+	fake := &clock.Fake{}
+	doFunc, attempts := failing(myError, myError)
+	policy := Policy{MaxAttempts: 5, Backoff: Constant(time.Second), Now: fake.Now, After: fake.After}
+	Assert(Do[int](ctx, policy, doFunc).Unwrap() == 123)
+	Assert(*attempts == 3)
+	Assert(reflect.DeepEqual(fake.Delays(), []time.Duration{time.Second, time.Second}))
+
+	doFunc, attempts = failing(myError, myError, myError)
+	err := Do[int](ctx, Policy{MaxAttempts: 2}, doFunc).UnwrapErr()
+	var retryErr *Error
+	Assert(errors.As(err, &retryErr) && retryErr.Attempts == 2 && *attempts == 2)
+	Assert(reflect.DeepEqual(retryErr.Errors, []error{myError, myError}))
+	Assert(errors.Is(err, myError))
+	Assert(err.Error() == "gave up after 2 attempts: MyError; MyError")
+
+	doFunc, attempts = failing(myError, fatal, myError)
+	policy = Policy{Retryable: func(err error) bool {return err != fatal}}
+	err = Do[int](ctx, policy, doFunc).UnwrapErr()
+	Assert(errors.As(err, &retryErr) && retryErr.Attempts == 2 && errors.Is(err, fatal))
+
+	fake = &clock.Fake{}
+	doFunc, attempts = failing(myError, myError, myError, myError, myError)
+	policy = Policy{MaxElapsed: 10 * time.Second, Backoff: Exponential(time.Second, time.Minute), Now: fake.Now, After: fake.After}
+	err = Do[int](ctx, policy, doFunc).UnwrapErr()
+	// 1s + 2s + 4s = 7s, another 8s would go past 10s.
+	Assert(reflect.DeepEqual(fake.Delays(), []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}))
+	Assert(errors.As(err, &retryErr) && retryErr.Attempts == 4)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	doFunc, attempts = failing(myError, myError)
+	cancelling := func(ctx context.Context) Result[int] {cancel(); return doFunc(ctx)}
+	err = Do[int](cancelled, Policy{Backoff: Constant(time.Hour)}, cancelling).UnwrapErr()
+	Assert(errors.Is(err, context.Canceled) && errors.Is(err, myError) && *attempts == 1)
+	doFunc, attempts = failing()
+	Assert(Do[int](cancelled, Policy{}, doFunc).UnwrapErr() == context.Canceled && *attempts == 0)
+
+	doFunc, _ = failing(myError)
+	Assert(Do[int](ctx, Policy{MaxAttempts: 2, Backoff: Constant(time.Millisecond)}, doFunc).Unwrap() == 123) // time.After.
+}
+
+func TestBackoff(t *testing.T) {
+	Assert(Constant(time.Second)(1) == time.Second && Constant(time.Second)(5) == time.Second)
+	exponential := Exponential(time.Second, 10*time.Second)
+	Assert(exponential(1) == time.Second)
+	Assert(exponential(2) == 2*time.Second)
+	Assert(exponential(4) == 8*time.Second)
+	Assert(exponential(5) == 10*time.Second)
+	Assert(exponential(100) == 10*time.Second)
+	jitter := Jitter(Constant(time.Second), 0.5, rand.New(rand.NewSource(1)))
+	for retry := 1; retry < 100; retry++ {
+		delay := jitter(retry)
+		Assert(delay >= 500*time.Millisecond && delay <= 1500*time.Millisecond)
+	}
+	Assert(Jitter(Constant(time.Second), 0, nil)(1) == time.Second)
+}
+
+func TestSharedPolicy(t *testing.T) {
+	myError := errors.New("MyError")
+	policy := Policy{MaxAttempts: 3, Backoff: Jitter(Constant(time.Microsecond), 0.5, rand.New(rand.NewSource(1)))}
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			doFunc, attempts := failing(myError, myError)
+			Assert(Do[int](context.Background(), policy, doFunc).Unwrap() == 123 && *attempts == 3)
+		}()
+	}
+	wg.Wait()
+}
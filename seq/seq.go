@@ -0,0 +1,114 @@
+package seq
+
+import (
+	"iter"
+	. "github.com/Sh1kharGupta/easyerror"
+)
+
+// (Value, nil) -> Ok{Value}
+// (Value, err) -> Err{err}
+func FromSeq2[T any](input iter.Seq2[T, error]) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for value, err := range input {
+			var output Result[T]
+			if err == nil {
+				output = &Ok[T]{value}
+			} else {
+				output = &Err[T]{err}
+			}
+			if !yield(output) {
+				return
+			}
+		}
+	}
+}
+
+// Ok{Value} -> (Value, nil)
+// Err{Error} -> (zero value, Error)
+func ToSeq2[T any](input iter.Seq[Result[T]]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for res := range input {
+			var value T
+			var err error
+			if res.IsOk() {
+				value = res.Unwrap()
+			} else {
+				err = res.UnwrapErr()
+			}
+			if !yield(value, err) {
+				return
+			}
+		}
+	}
+}
+
+// Ok{Value} -> Ok{func(Value)}
+// Err{Error} -> Err{Error}
+func Map[T1, T2 any](input iter.Seq[Result[T1]], transformFunc func(T1) T2) iter.Seq[Result[T2]] {
+	return func(yield func(Result[T2]) bool) {
+		for res := range input {
+			var output Result[T2]
+			if res.IsOk() {
+				output = &Ok[T2]{transformFunc(res.Unwrap())}
+			} else {
+				output = &Err[T2]{res.UnwrapErr()}
+			}
+			if !yield(output) {
+				return
+			}
+		}
+	}
+}
+
+// Ok{Value} -> kept if func(Value) is true, else dropped
+// Err{Error} -> kept
+func Filter[T any](input iter.Seq[Result[T]], filterFunc func(T) bool) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for res := range input {
+			if res.IsOk() && !filterFunc(res.Unwrap()) {
+				continue
+			}
+			if !yield(res) {
+				return
+			}
+		}
+	}
+}
+
+// Yields the values of the leading Ok{Value} elements.
+// Stops silently at the first Err{Error}.
+func TakeWhileOk[T any](input iter.Seq[Result[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for res := range input {
+			if res.IsErr() || !yield(res.Unwrap()) {
+				return
+			}
+		}
+	}
+}
+
+// Yields the leading Ok{Value} elements followed by the first Err{Error}, if any.
+// Nothing is pulled from the input after the first Err{Error}.
+func StopOnErr[T any](input iter.Seq[Result[T]]) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for res := range input {
+			if !yield(res) || res.IsErr() {
+				return
+			}
+		}
+	}
+}
+
+// [Ok{Value1}, Ok{Value2}, ...] -> Ok{[Value1, Value2, ...]}
+// Any Err{Error} -> the first Err{Error}
+// Nothing is pulled from the input after the first Err{Error}.
+func Collect[T any](input iter.Seq[Result[T]]) Result[[]T] {
+	values := []T{}
+	for res := range input {
+		if res.IsErr() {
+			return &Err[[]T]{res.UnwrapErr()}
+		}
+		values = append(values, res.Unwrap())
+	}
+	return &Ok[[]T]{values}
+}
@@ -0,0 +1,66 @@
+package seq
+
+import (
+	"errors"
+	"iter"
+	"reflect"
+	"slices"
+	"testing"
+	. "github.com/Sh1kharGupta/easyerror"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestSeq(t *testing.T) {
+	myError := errors.New("MyError")
+	pulled := 0
+	input := func(yield func(Result[int]) bool) {
+		for _, res := range []Result[int]{&Ok[int]{1}, &Ok[int]{2}, &Err[int]{myError}, &Ok[int]{3}} {
+			pulled++
+			if !yield(res) {
+				return
+			}
+		}
+	}
+	okInput := slices.Values([]Result[int]{&Ok[int]{1}, &Ok[int]{2}})
+	double := func(x int) int {return x * 2}
+	isOdd := func(x int) bool {return x%2 == 1}
+
+	Assert(reflect.DeepEqual(Collect[int](Map[int, int](okInput, double)).Unwrap(), []int{2, 4}))
+	Assert(reflect.DeepEqual(Collect[int](Filter[int](okInput, isOdd)).Unwrap(), []int{1}))
+	Assert(reflect.DeepEqual(Collect[int](slices.Values([]Result[int]{})).Unwrap(), []int{}))
+	pulled = 0
+	Assert(Collect[int](input).UnwrapErr() == myError)
+	Assert(pulled == 3)
+	Assert(len(slices.Collect(Filter[int](input, isOdd))) == 3) // Err is kept.
+	Assert(slices.Collect(Map[int, int](input, double))[2].UnwrapErr() == myError)
+	pulled = 0
+	Assert(reflect.DeepEqual(slices.Collect(TakeWhileOk[int](input)), []int{1, 2}))
+	Assert(pulled == 3)
+	pulled = 0
+	stopped := slices.Collect(StopOnErr[int](input))
+	Assert(len(stopped) == 3 && stopped[2].UnwrapErr() == myError)
+	Assert(pulled == 3)
+	for range StopOnErr[int](input) {
+		break // Early exit must not panic.
+	}
+	for range TakeWhileOk[int](input) {
+		break
+	}
+
+	var pairs iter.Seq2[int, error] = ToSeq2[int](input)
+	values, errs := []int{}, []error{}
+	for value, err := range pairs {
+		values = append(values, value)
+		errs = append(errs, err)
+	}
+	Assert(reflect.DeepEqual(values, []int{1, 2, 0, 3}))
+	Assert(reflect.DeepEqual(errs, []error{nil, nil, myError, nil}))
+	roundTrip := slices.Collect(FromSeq2[int](pairs))
+	Assert(len(roundTrip) == 4 && roundTrip[0].Unwrap() == 1 && roundTrip[2].UnwrapErr() == myError)
+	for range FromSeq2[int](pairs) {
+		break
+	}
+	for range ToSeq2[int](input) {
+		break
+	}
+}
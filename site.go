@@ -0,0 +1,49 @@
+package easyerror
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+)
+
+// Wraps an error caught by result.Catch() with the location of the Unwrap()
+// or Expect() call which panicked. Use errors.As() to retrieve it and fmt's
+// %+v verb to print the location.
+type SiteError struct {
+	Err error
+	// Program counter of the failed call, as returned by runtime.Callers().
+	PC uintptr
+}
+
+func (self *SiteError) Error() string {
+	return self.Err.Error()
+}
+
+func (self *SiteError) Unwrap() error {
+	return self.Err
+}
+
+// Returns the file and line of the failed Unwrap() or Expect() call.
+func (self *SiteError) Site() (file string, line int) {
+	frame, _ := runtime.CallersFrames([]uintptr{self.PC}).Next()
+	return frame.File, frame.Line
+}
+
+// %s, %v and any other verb -> error message
+// %q -> quoted error message
+// %+v -> %+v of the wrapped error followed by "\nunwrapped at file:line"
+func (self *SiteError) Format(state fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if state.Flag('+') {
+			file, line := self.Site()
+			fmt.Fprintf(state, "%+v\nunwrapped at %s:%d", self.Err, file, line)
+			return
+		}
+		io.WriteString(state, self.Error())
+	case 'q':
+		fmt.Fprintf(state, "%q", self.Error())
+	default:
+		io.WriteString(state, self.Error())
+	}
+}
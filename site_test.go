@@ -0,0 +1,31 @@
+package easyerror
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestSiteError(t *testing.T) {
+	defer func(old bool) {CaptureStack = old}(CaptureStack)
+	myError := errors.New("MyError")
+	pc, file, line, _ := runtime.Caller(0)
+	err := &SiteError{myError, pc}
+	siteFile, siteLine := err.Site()
+	Assert(siteFile == file && siteLine == line)
+	Assert(errors.Is(err, myError))
+	Assert(fmt.Sprintf("%v", err) == "MyError")
+	Assert(fmt.Sprintf("%s", err) == "MyError")
+	Assert(fmt.Sprintf("%q", err) == `"MyError"`)
+	Assert(fmt.Sprintf("%d", err) == "MyError" && fmt.Sprintf("%x", err) == "MyError")
+	Assert(fmt.Sprintf("%+v", err) == fmt.Sprintf("MyError\nunwrapped at %s:%d", file, line))
+
+	CaptureStack = true
+	err = &SiteError{WithStack(myError), pc}
+	verbose := fmt.Sprintf("%+v", err)
+	Assert(strings.HasPrefix(verbose, "MyError\ngithub.com/Sh1kharGupta/easyerror.TestSiteError\n\t"))
+	Assert(strings.HasSuffix(verbose, fmt.Sprintf("\nunwrapped at %s:%d", file, line)))
+}
@@ -1,5 +1,7 @@
 package easyerror
 
+import "iter"
+
 // Implements the Option interface. Holds some value.
 // See the interface for documentation of methods.
 type Some[T any] struct {
@@ -14,6 +16,18 @@ func (self *Some[T]) IsNone() bool {
 	return false;
 }
 
+func (self *Some[T]) IsSomeAnd(predicate func(T) bool) bool {
+	return predicate(self.Value)
+}
+
+func (self *Some[T]) IsNoneOr(predicate func(T) bool) bool {
+	return predicate(self.Value)
+}
+
+func (self *Some[T]) Expect(msg string) T {
+	return self.Value
+}
+
 func (self *Some[T]) Unwrap() T {
 	return self.Value
 }
@@ -26,6 +40,10 @@ func (self *Some[T]) UnwrapOrElse(defaultFunc func() T) T {
 	return self.Value
 }
 
+func (self *Some[T]) UnwrapOrDefault() T {
+	return self.Value
+}
+
 func (self *Some[T]) OkOr(err error) Result[T] {
 	return &Ok[T]{self.Value}
 }
@@ -84,3 +102,14 @@ func (self *Some[T]) AndThen(transformFunc func(T) Option[T]) Option[T] {
 func (self *Some[T]) OrElse(defaultFunc func() Option[T]) Option[T] {
 	return self
 }
+
+func (self *Some[T]) Inspect(inspectFunc func(T)) Option[T] {
+	inspectFunc(self.Value)
+	return self
+}
+
+func (self *Some[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		yield(self.Value)
+	}
+}
@@ -0,0 +1,87 @@
+package easyerror
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+)
+
+// Maximum number of frames recorded by WithStack().
+const maxStackDepth = 32
+
+// Controls whether errors put into Err{} by OkOr(), OkOrElse(), Expect(),
+// result.Convert() and result.NewErr() carry the call stack of their origin.
+// Defaults to false; build with `-tags easyerror_stack` to default it to true.
+var CaptureStack = captureStackDefault
+
+// Wraps an error along with the call stack at the point where it was wrapped.
+// Use errors.As() to retrieve it and fmt's %+v verb to print the frames.
+type StackError struct {
+	err   error
+	stack []uintptr
+}
+
+// Returns err wrapped in a StackError holding the caller's stack.
+// err is returned as is if it is nil, if it already carries a stack or if
+// CaptureStack is false.
+func WithStack(err error) error {
+	return withStack(err, 1)
+}
+
+// skip is the number of frames above the caller of withStack() to leave out
+// of the recorded stack.
+func withStack(err error, skip int) error {
+	if err == nil || !CaptureStack {
+		return err
+	}
+	var stackErr *StackError
+	if errors.As(err, &stackErr) {
+		return err
+	}
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(skip+2, pcs)
+	return &StackError{err, pcs[:n]}
+}
+
+func (self *StackError) Error() string {
+	return self.err.Error()
+}
+
+func (self *StackError) Unwrap() error {
+	return self.err
+}
+
+// Returns the recorded frames, innermost first.
+func (self *StackError) StackTrace() []runtime.Frame {
+	frames := runtime.CallersFrames(self.stack)
+	var ret []runtime.Frame
+	for {
+		frame, more := frames.Next()
+		ret = append(ret, frame)
+		if !more {
+			return ret
+		}
+	}
+}
+
+// %s, %v and any other verb -> error message
+// %q -> quoted error message
+// %+v -> error message followed by one "function\n\tfile:line" entry per frame
+func (self *StackError) Format(state fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if state.Flag('+') {
+			fmt.Fprintf(state, "%+v", self.err)
+			for _, frame := range self.StackTrace() {
+				fmt.Fprintf(state, "\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+			}
+			return
+		}
+		io.WriteString(state, self.Error())
+	case 'q':
+		fmt.Fprintf(state, "%q", self.Error())
+	default:
+		io.WriteString(state, self.Error())
+	}
+}
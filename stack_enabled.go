@@ -0,0 +1,5 @@
+//go:build easyerror_stack
+
+package easyerror
+
+const captureStackDefault = true
@@ -0,0 +1,46 @@
+package easyerror
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"github.com/Sh1kharGupta/easyerror/internal/sentinel"
+	. "github.com/Sh1kharGupta/easyerror/test_utils"
+)
+
+func TestStack(t *testing.T) {
+	defer func(old bool) {CaptureStack = old}(CaptureStack)
+	myError := errors.New("MyError")
+	none := &None[int]{}
+
+	CaptureStack = false
+	Assert(WithStack(myError) == myError)
+	Assert(none.OkOr(myError).UnwrapErr() == myError)
+
+	CaptureStack = true
+	Assert(WithStack(nil) == nil)
+	var stackErr *StackError
+	err := none.OkOr(myError).UnwrapErr()
+	Assert(errors.As(err, &stackErr))
+	Assert(errors.Is(err, myError))
+	Assert(stackErr.StackTrace()[0].Function == "github.com/Sh1kharGupta/easyerror.TestStack")
+	Assert(WithStack(err) == err) // The origin is kept.
+	Assert(fmt.Sprintf("%v", err) == "MyError")
+	Assert(fmt.Sprintf("%s", err) == "MyError")
+	Assert(fmt.Sprintf("%q", err) == `"MyError"`)
+	Assert(fmt.Sprintf("%d", err) == "MyError" && fmt.Sprintf("%x", err) == "MyError")
+	verbose := fmt.Sprintf("%+v", err)
+	Assert(strings.HasPrefix(verbose, "MyError\ngithub.com/Sh1kharGupta/easyerror.TestStack\n\t"))
+	Assert(strings.Contains(verbose, "stack_test.go:"))
+
+	err = none.OkOrElse(func() error {return myError}).UnwrapErr()
+	Assert(errors.As(err, &stackErr))
+	Assert(stackErr.StackTrace()[0].Function == "github.com/Sh1kharGupta/easyerror.TestStack")
+
+	plain := &Err[int]{myError}
+	err = Recover[*sentinel.Panic](func() {plain.Expect("panic")}).Err.(error)
+	Assert(err.Error() == "panic: MyError")
+	Assert(errors.As(err, &stackErr))
+	Assert(strings.HasPrefix(stackErr.StackTrace()[0].Function, "github.com/Sh1kharGupta/easyerror.TestStack"))
+}